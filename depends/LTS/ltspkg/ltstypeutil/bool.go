@@ -0,0 +1,67 @@
+package ltstypeutil
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/juju/errors"
+)
+
+// Bool is a wrapper of bool for config fields sourced from free-form text
+// (env vars, TOML/YAML authored by hand) where "yes"/"no" and "on"/"off"
+// are as common as "true"/"false".
+type Bool bool
+
+// parseBool parses s case-insensitively, accepting "true"/"false",
+// "yes"/"no", "on"/"off" and "1"/"0".
+func parseBool(s string) (bool, error) {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "true", "yes", "on", "1":
+		return true, nil
+	case "false", "no", "off", "0":
+		return false, nil
+	default:
+		return false, errors.Errorf("ltstypeutil: invalid Bool %q", s)
+	}
+}
+
+// String renders b as "true" or "false".
+func (b Bool) String() string {
+	return strconv.FormatBool(bool(b))
+}
+
+// MarshalJSON returns b as a JSON boolean literal.
+func (b Bool) MarshalJSON() ([]byte, error) {
+	return []byte(strconv.FormatBool(bool(b))), nil
+}
+
+// UnmarshalJSON accepts a JSON boolean literal or a quoted string in any of
+// the forms parseBool understands.
+func (b *Bool) UnmarshalJSON(text []byte) error {
+	if len(text) == 0 {
+		return errors.New("ltstypeutil: empty Bool")
+	}
+	if text[0] == '"' {
+		s, err := strconv.Unquote(string(text))
+		if err != nil {
+			return errors.Trace(err)
+		}
+		return errors.Trace(b.UnmarshalText([]byte(s)))
+	}
+	parsed, err := strconv.ParseBool(string(text))
+	if err != nil {
+		return errors.Trace(err)
+	}
+	*b = Bool(parsed)
+	return nil
+}
+
+// UnmarshalText parses text with parseBool.
+func (b *Bool) UnmarshalText(text []byte) error {
+	parsed, err := parseBool(string(text))
+	if err != nil {
+		return errors.Trace(err)
+	}
+	*b = Bool(parsed)
+	return nil
+}
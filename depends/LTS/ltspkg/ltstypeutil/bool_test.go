@@ -0,0 +1,63 @@
+package ltstypeutil
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestBoolUnmarshalTextMatrix(t *testing.T) {
+	truthy := []string{"true", "TRUE", "yes", "Yes", "on", "1"}
+	falsy := []string{"false", "FALSE", "no", "No", "off", "0"}
+
+	for _, s := range truthy {
+		var b Bool
+		if err := b.UnmarshalText([]byte(s)); err != nil {
+			t.Errorf("UnmarshalText(%q): %v", s, err)
+		} else if !bool(b) {
+			t.Errorf("UnmarshalText(%q) = false, want true", s)
+		}
+	}
+	for _, s := range falsy {
+		var b Bool
+		if err := b.UnmarshalText([]byte(s)); err != nil {
+			t.Errorf("UnmarshalText(%q): %v", s, err)
+		} else if bool(b) {
+			t.Errorf("UnmarshalText(%q) = true, want false", s)
+		}
+	}
+}
+
+func TestBoolUnmarshalTextInvalid(t *testing.T) {
+	var b Bool
+	if err := b.UnmarshalText([]byte("maybe")); err == nil {
+		t.Error("UnmarshalText(\"maybe\"): expected an error, got nil")
+	}
+}
+
+func TestBoolJSONRoundTrip(t *testing.T) {
+	var b Bool
+	if err := json.Unmarshal([]byte(`"yes"`), &b); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+	if !bool(b) {
+		t.Errorf("got false, want true")
+	}
+
+	data, err := json.Marshal(b)
+	if err != nil {
+		t.Fatalf("Marshal: %v", err)
+	}
+	if string(data) != "true" {
+		t.Errorf("Marshal = %s, want true", data)
+	}
+}
+
+func TestBoolUnmarshalJSONLiteral(t *testing.T) {
+	var b Bool
+	if err := json.Unmarshal([]byte("false"), &b); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+	if bool(b) {
+		t.Errorf("got true, want false")
+	}
+}
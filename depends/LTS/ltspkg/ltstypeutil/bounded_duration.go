@@ -0,0 +1,187 @@
+package ltstypeutil
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+
+	"github.com/BurntSushi/toml"
+	"github.com/juju/errors"
+	yaml "gopkg.in/yaml.v2"
+)
+
+// Format identifies the serialization format DecodeStruct should use to
+// unmarshal data before applying bounds validation.
+type Format int
+
+const (
+	// JSON decodes data with encoding/json.
+	JSON Format = iota
+	// TOML decodes data with BurntSushi/toml.
+	TOML
+	// YAML decodes data with gopkg.in/yaml.v2.
+	YAML
+)
+
+// durTag is the struct-tag name DecodeStruct reads bounds and defaults
+// from, e.g. `dur:"min=1s,max=24h,default=30s"`.
+const durTag = "dur"
+
+// Validator validates value, the reflected value of a struct field tagged
+// with the Validator's registered name, returning a descriptive error if
+// the value is invalid.
+type Validator func(field reflect.StructField, value reflect.Value) error
+
+var validatorRegistry = map[string]Validator{}
+
+// RegisterValidator registers validator under tagName. DecodeStruct invokes
+// it on every field carrying a struct tag named tagName, after decoding and
+// after the built-in "dur" bounds check.
+func RegisterValidator(tagName string, validator Validator) {
+	validatorRegistry[tagName] = validator
+}
+
+// DecodeStruct unmarshals data into out (which must be a pointer to a
+// struct) according to format, then walks out applying defaults and
+// min/max bounds declared via `dur:"min=...,max=...,default=..."` struct
+// tags on Duration fields, followed by any validator registered with
+// RegisterValidator.
+func DecodeStruct(data []byte, format Format, out interface{}) error {
+	switch format {
+	case JSON:
+		if err := json.Unmarshal(data, out); err != nil {
+			return errors.Trace(err)
+		}
+	case TOML:
+		if err := toml.Unmarshal(data, out); err != nil {
+			return errors.Trace(err)
+		}
+	case YAML:
+		if err := yaml.Unmarshal(data, out); err != nil {
+			return errors.Trace(err)
+		}
+	default:
+		return errors.Errorf("ltstypeutil: unknown Format %d", format)
+	}
+
+	v := reflect.ValueOf(out)
+	if v.Kind() != reflect.Ptr || v.Elem().Kind() != reflect.Struct {
+		return errors.Errorf("ltstypeutil: DecodeStruct out must be a pointer to a struct, got %T", out)
+	}
+	return errors.Trace(walkStruct(v.Elem()))
+}
+
+// walkStruct recurses into v, a struct value, applying the "dur" bounds
+// check and the registered validators to every tagged field. The "dur"
+// check supports both Duration and *Duration fields, allocating a zero
+// Duration for a nil pointer before applying defaults and bounds.
+func walkStruct(v reflect.Value) error {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		fv := v.Field(i)
+		if !fv.CanSet() {
+			continue
+		}
+
+		if tag, ok := field.Tag.Lookup(durTag); ok {
+			switch d := fv.Addr().Interface().(type) {
+			case *Duration:
+				if err := applyDurTag(field, d, tag); err != nil {
+					return errors.Trace(err)
+				}
+			case **Duration:
+				if *d == nil {
+					*d = new(Duration)
+				}
+				if err := applyDurTag(field, *d, tag); err != nil {
+					return errors.Trace(err)
+				}
+			}
+		}
+
+		for tagName, validator := range validatorRegistry {
+			if _, ok := field.Tag.Lookup(tagName); ok {
+				if err := validator(field, fv); err != nil {
+					return errors.Annotatef(err, "field %s", field.Name)
+				}
+			}
+		}
+
+		switch fv.Kind() {
+		case reflect.Struct:
+			if err := walkStruct(fv); err != nil {
+				return errors.Trace(err)
+			}
+		case reflect.Ptr:
+			if !fv.IsNil() && fv.Elem().Kind() == reflect.Struct {
+				if err := walkStruct(fv.Elem()); err != nil {
+					return errors.Trace(err)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// durTagOptions holds the parsed options of a `dur:"..."` struct tag.
+type durTagOptions struct {
+	min          *Duration
+	max          *Duration
+	defaultValue *Duration
+}
+
+// applyDurTag applies a field's `dur` tag to d: it fills in the default
+// when d is zero, then validates d against the declared min/max bounds.
+func applyDurTag(field reflect.StructField, d *Duration, tag string) error {
+	opts, err := parseDurTag(tag)
+	if err != nil {
+		return errors.Annotatef(err, "field %s", field.Name)
+	}
+
+	if d.IsZero() && opts.defaultValue != nil {
+		*d = *opts.defaultValue
+	}
+
+	if opts.min != nil && d.Duration < opts.min.Duration {
+		return errors.Errorf("field %s: duration %s is below the minimum of %s", field.Name, d, opts.min)
+	}
+	if opts.max != nil && d.Duration > opts.max.Duration {
+		return errors.Errorf("field %s: duration %s exceeds the maximum of %s", field.Name, d, opts.max)
+	}
+	return nil
+}
+
+// parseDurTag parses a `dur:"min=1s,max=24h,default=30s"` tag value. Each
+// option's duration is parsed with ParseExtendedDuration, so the extended
+// "d"/"w"/"y" units and bare unitless numbers are accepted there too.
+func parseDurTag(tag string) (*durTagOptions, error) {
+	opts := &durTagOptions{}
+	for _, part := range strings.Split(tag, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		kv := strings.SplitN(part, "=", 2)
+		if len(kv) != 2 {
+			return nil, errors.Errorf("malformed dur tag option %q", part)
+		}
+		key, value := strings.TrimSpace(kv[0]), strings.TrimSpace(kv[1])
+		duration, err := ParseExtendedDuration(value)
+		if err != nil {
+			return nil, errors.Annotatef(err, "dur tag option %s", key)
+		}
+		d := NewDuration(duration)
+		switch key {
+		case "min":
+			opts.min = &d
+		case "max":
+			opts.max = &d
+		case "default":
+			opts.defaultValue = &d
+		default:
+			return nil, errors.Errorf("unknown dur tag option %q", key)
+		}
+	}
+	return opts, nil
+}
@@ -2,6 +2,7 @@ package ltstypeutil
 
 import (
 	"fmt"
+	"regexp"
 	"strconv"
 	"time"
 
@@ -18,18 +19,125 @@ func NewDuration(duration time.Duration) Duration {
 	return Duration{Duration: duration}
 }
 
+// ZeroDuration is the zero-value Duration.
+var ZeroDuration = Duration{}
+
+// IsZero reports whether d is the zero duration.
+func (d Duration) IsZero() bool {
+	return d.Duration == 0
+}
+
+// Add returns t advanced by d.
+func (d Duration) Add(t time.Time) time.Time {
+	return t.Add(d.Duration)
+}
+
+// Mul returns d scaled by factor.
+func (d Duration) Mul(factor float64) Duration {
+	return NewDuration(time.Duration(float64(d.Duration) * factor))
+}
+
+// Clamp restricts d to the closed interval [min, max].
+func (d Duration) Clamp(min, max Duration) Duration {
+	switch {
+	case d.Duration < min.Duration:
+		return min
+	case d.Duration > max.Duration:
+		return max
+	default:
+		return d
+	}
+}
+
+// Since returns the Duration elapsed since ts.
+func Since(ts Timestamp) Duration {
+	return NewDuration(time.Since(ts.Time))
+}
+
 // MarshalJSON returns the duration as a JSON string.
 func (d *Duration) MarshalJSON() ([]byte, error) {
 	return []byte(fmt.Sprintf(`"%s"`, d.String())), nil
 }
 
-// UnmarshalJSON parses a JSON string into the duration.
+// UnmarshalJSON parses a JSON string or number into the duration. A quoted
+// string is parsed with ParseExtendedDuration; a bare numeric token is
+// interpreted as nanoseconds, matching time.Duration's own representation.
 func (d *Duration) UnmarshalJSON(text []byte) error {
-	s, err := strconv.Unquote(string(text))
+	if len(text) == 0 {
+		return errors.New("empty duration")
+	}
+	if text[0] == '"' {
+		s, err := strconv.Unquote(string(text))
+		if err != nil {
+			return errors.Trace(err)
+		}
+		duration, err := ParseExtendedDuration(s)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		d.Duration = duration
+		return nil
+	}
+	ns, err := strconv.ParseInt(string(text), 10, 64)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	d.Duration = time.Duration(ns)
+	return nil
+}
+
+// MarshalYAML returns the duration as a YAML string.
+func (d *Duration) MarshalYAML() (interface{}, error) {
+	return d.String(), nil
+}
+
+// UnmarshalYAML parses a YAML string or integer into the duration: a
+// string is parsed with ParseExtendedDuration, and an integer is
+// interpreted as nanoseconds. The scalar is decoded into interface{} first
+// because a bare integer also unmarshals successfully into a string
+// target, which would otherwise always take the string branch.
+func (d *Duration) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var raw interface{}
+	if err := unmarshal(&raw); err != nil {
+		return errors.Trace(err)
+	}
+
+	switch v := raw.(type) {
+	case string:
+		duration, err := ParseExtendedDuration(v)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		d.Duration = duration
+		return nil
+	case int:
+		d.Duration = time.Duration(v)
+		return nil
+	case int64:
+		d.Duration = time.Duration(v)
+		return nil
+	case uint64:
+		d.Duration = time.Duration(v)
+		return nil
+	default:
+		return errors.Errorf("ltstypeutil: cannot unmarshal %T into Duration", raw)
+	}
+}
+
+// UnmarshalText parses a TOML string into the duration.
+func (d *Duration) UnmarshalText(text []byte) error {
+	duration, err := ParseExtendedDuration(string(text))
 	if err != nil {
 		return errors.Trace(err)
 	}
-	duration, err := time.ParseDuration(s)
+	d.Duration = duration
+	return nil
+}
+
+// Set parses s into the duration, so Duration also satisfies flag.Value and
+// can be wired up directly as a CLI flag.
+func (d *Duration) Set(s string) error {
+	duration, err := ParseExtendedDuration(s)
 	if err != nil {
 		return errors.Trace(err)
 	}
@@ -37,9 +145,47 @@ func (d *Duration) UnmarshalJSON(text []byte) error {
 	return nil
 }
 
-// UnmarshalText parses a TOML string into the duration.
-func (d *Duration) UnmarshalText(text []byte) error {
-	var err error
-	d.Duration, err = time.ParseDuration(string(text))
-	return errors.Trace(err)
+const (
+	hoursPerDay  = 24
+	hoursPerWeek = 7 * hoursPerDay
+	hoursPerYear = 365 * hoursPerDay
+)
+
+// extendedUnitPattern matches a run of <number><d|w|y>, the units beyond
+// what time.ParseDuration understands natively.
+var extendedUnitPattern = regexp.MustCompile(`(\d+(?:\.\d+)?)(d|w|y)`)
+
+// ParseExtendedDuration parses s like time.ParseDuration, but additionally
+// accepts:
+//   - a bare integer or float with no unit, interpreted as seconds;
+//   - the unit suffixes "d" (24h), "w" (7d) and "y" (365d), composable with
+//     the Go built-in units (e.g. "1w12h30m").
+func ParseExtendedDuration(s string) (time.Duration, error) {
+	if seconds, err := strconv.ParseFloat(s, 64); err == nil {
+		return time.Duration(seconds * float64(time.Second)), nil
+	}
+
+	expanded := extendedUnitPattern.ReplaceAllStringFunc(s, func(token string) string {
+		m := extendedUnitPattern.FindStringSubmatch(token)
+		n, err := strconv.ParseFloat(m[1], 64)
+		if err != nil {
+			return token
+		}
+		var hours float64
+		switch m[2] {
+		case "d":
+			hours = n * hoursPerDay
+		case "w":
+			hours = n * hoursPerWeek
+		case "y":
+			hours = n * hoursPerYear
+		}
+		return strconv.FormatFloat(hours, 'f', -1, 64) + "h"
+	})
+
+	duration, err := time.ParseDuration(expanded)
+	if err != nil {
+		return 0, errors.Trace(err)
+	}
+	return duration, nil
 }
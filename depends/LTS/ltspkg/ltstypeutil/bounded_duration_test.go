@@ -0,0 +1,110 @@
+package ltstypeutil
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/juju/errors"
+)
+
+func TestDecodeStructAppliesDefaultToZeroDuration(t *testing.T) {
+	type config struct {
+		GCInterval Duration `json:"gc_interval" dur:"min=1s,max=24h,default=30s"`
+	}
+	var c config
+	if err := DecodeStruct([]byte(`{}`), JSON, &c); err != nil {
+		t.Fatalf("DecodeStruct: %v", err)
+	}
+	if c.GCInterval.Duration != 30*time.Second {
+		t.Errorf("GCInterval = %s, want 30s", c.GCInterval)
+	}
+}
+
+func TestDecodeStructRejectsOutOfRangeDuration(t *testing.T) {
+	type config struct {
+		GCInterval Duration `json:"gc_interval" dur:"min=1s,max=24h,default=30s"`
+	}
+	var c config
+	err := DecodeStruct([]byte(`{"gc_interval":"10000h"}`), JSON, &c)
+	if err == nil {
+		t.Fatal("DecodeStruct: expected an error for an out-of-range duration, got nil")
+	}
+	if !strings.Contains(err.Error(), "GCInterval") {
+		t.Errorf("error %q does not name the offending field", err)
+	}
+}
+
+func TestDecodeStructAppliesBoundsToPointerDuration(t *testing.T) {
+	type config struct {
+		GCInterval *Duration `json:"gc_interval" dur:"min=1s,max=2s"`
+	}
+	var c config
+	err := DecodeStruct([]byte(`{}`), JSON, &c)
+	if err == nil {
+		t.Fatal("DecodeStruct: expected an error for a zero *Duration below the minimum, got nil")
+	}
+}
+
+func TestDecodeStructAcceptsInRangePointerDuration(t *testing.T) {
+	type config struct {
+		GCInterval *Duration `json:"gc_interval" dur:"min=1s,max=2s"`
+	}
+	var c config
+	if err := DecodeStruct([]byte(`{"gc_interval":"1500ms"}`), JSON, &c); err != nil {
+		t.Fatalf("DecodeStruct: %v", err)
+	}
+	if c.GCInterval == nil || c.GCInterval.Duration != 1500*time.Millisecond {
+		t.Errorf("GCInterval = %v, want 1500ms", c.GCInterval)
+	}
+}
+
+func TestDecodeStructTOMLAndYAML(t *testing.T) {
+	type config struct {
+		GCInterval Duration `toml:"gc_interval" yaml:"gc_interval" dur:"min=1s,max=24h,default=30s"`
+	}
+
+	var tomlConfig config
+	if err := DecodeStruct([]byte(`gc_interval = "5m"`), TOML, &tomlConfig); err != nil {
+		t.Fatalf("DecodeStruct(TOML): %v", err)
+	}
+	if tomlConfig.GCInterval.Duration != 5*time.Minute {
+		t.Errorf("TOML GCInterval = %s, want 5m", tomlConfig.GCInterval)
+	}
+
+	var yamlConfig config
+	if err := DecodeStruct([]byte("gc_interval: 5m\n"), YAML, &yamlConfig); err != nil {
+		t.Fatalf("DecodeStruct(YAML): %v", err)
+	}
+	if yamlConfig.GCInterval.Duration != 5*time.Minute {
+		t.Errorf("YAML GCInterval = %s, want 5m", yamlConfig.GCInterval)
+	}
+}
+
+func TestDecodeStructCustomValidator(t *testing.T) {
+	type config struct {
+		Name string `json:"name" nonempty:"true"`
+	}
+
+	RegisterValidator("nonempty", func(field reflect.StructField, value reflect.Value) error {
+		if value.String() == "" {
+			return errors.Errorf("must not be empty")
+		}
+		return nil
+	})
+
+	var c config
+	err := DecodeStruct([]byte(`{"name":""}`), JSON, &c)
+	if err == nil {
+		t.Fatal("DecodeStruct: expected an error from the registered validator, got nil")
+	}
+	if !strings.Contains(err.Error(), "Name") {
+		t.Errorf("error %q does not name the offending field", err)
+	}
+
+	var c2 config
+	if err := DecodeStruct([]byte(`{"name":"gc-worker"}`), JSON, &c2); err != nil {
+		t.Fatalf("DecodeStruct: %v", err)
+	}
+}
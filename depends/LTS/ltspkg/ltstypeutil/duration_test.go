@@ -0,0 +1,159 @@
+package ltstypeutil
+
+import (
+	"encoding/json"
+	"flag"
+	"testing"
+	"time"
+
+	yamlv2 "gopkg.in/yaml.v2"
+	yamlv3 "gopkg.in/yaml.v3"
+)
+
+var durationRoundTripCases = []Duration{
+	ZeroDuration,
+	NewDuration(30 * time.Second),
+	NewDuration(90 * time.Minute),
+}
+
+func TestDurationJSONRoundTrip(t *testing.T) {
+	for _, d := range durationRoundTripCases {
+		data, err := json.Marshal(&d)
+		if err != nil {
+			t.Fatalf("Marshal(%s): %v", d, err)
+		}
+		var got Duration
+		if err := json.Unmarshal(data, &got); err != nil {
+			t.Fatalf("Unmarshal(%s): %v", data, err)
+		}
+		if got.Duration != d.Duration {
+			t.Errorf("round trip mismatch: got %s, want %s", got, d)
+		}
+	}
+}
+
+func TestDurationZeroValueMarshalsAsZeroSeconds(t *testing.T) {
+	jsonData, err := json.Marshal(&ZeroDuration)
+	if err != nil {
+		t.Fatalf("Marshal: %v", err)
+	}
+	if string(jsonData) != `"0s"` {
+		t.Errorf("JSON zero value = %s, want \"0s\"", jsonData)
+	}
+
+	yamlOut, err := ZeroDuration.MarshalYAML()
+	if err != nil {
+		t.Fatalf("MarshalYAML: %v", err)
+	}
+	if yamlOut != "0s" {
+		t.Errorf("YAML zero value = %v, want 0s", yamlOut)
+	}
+}
+
+func TestDurationTextRoundTrip(t *testing.T) {
+	for _, d := range durationRoundTripCases {
+		var got Duration
+		if err := got.UnmarshalText([]byte(d.String())); err != nil {
+			t.Fatalf("UnmarshalText(%s): %v", d, err)
+		}
+		if got.Duration != d.Duration {
+			t.Errorf("round trip mismatch: got %s, want %s", got, d)
+		}
+	}
+}
+
+func TestDurationYAMLRoundTrip(t *testing.T) {
+	marshalers := map[string]func(interface{}) ([]byte, error){
+		"yaml.v2": yamlv2.Marshal,
+		"yaml.v3": yamlv3.Marshal,
+	}
+	unmarshalers := map[string]func([]byte, interface{}) error{
+		"yaml.v2": yamlv2.Unmarshal,
+		"yaml.v3": yamlv3.Unmarshal,
+	}
+
+	for name, marshal := range marshalers {
+		unmarshal := unmarshalers[name]
+		for _, d := range durationRoundTripCases {
+			data, err := marshal(&d)
+			if err != nil {
+				t.Fatalf("%s Marshal(%s): %v", name, d, err)
+			}
+			var got Duration
+			if err := unmarshal(data, &got); err != nil {
+				t.Fatalf("%s Unmarshal(%s): %v", name, data, err)
+			}
+			if got.Duration != d.Duration {
+				t.Errorf("%s round trip mismatch: got %s, want %s", name, got, d)
+			}
+		}
+	}
+}
+
+func TestParseExtendedDuration(t *testing.T) {
+	cases := []struct {
+		in   string
+		want time.Duration
+	}{
+		{"10", 10 * time.Second},
+		{"1.5", 1500 * time.Millisecond},
+		{"2d", 48 * time.Hour},
+		{"1w", 7 * 24 * time.Hour},
+		{"1y", 365 * 24 * time.Hour},
+		{"1w12h30m", 7*24*time.Hour + 12*time.Hour + 30*time.Minute},
+		{"90m", 90 * time.Minute},
+	}
+	for _, c := range cases {
+		got, err := ParseExtendedDuration(c.in)
+		if err != nil {
+			t.Fatalf("ParseExtendedDuration(%q): %v", c.in, err)
+		}
+		if got != c.want {
+			t.Errorf("ParseExtendedDuration(%q) = %s, want %s", c.in, got, c.want)
+		}
+	}
+}
+
+func TestDurationSet(t *testing.T) {
+	var d Duration
+	if err := d.Set("2d"); err != nil {
+		t.Fatalf("Set: %v", err)
+	}
+	if d.Duration != 48*time.Hour {
+		t.Errorf("Set(\"2d\") = %s, want 48h", d)
+	}
+
+	var flagValue flag.Value = &d
+	if err := flagValue.Set("90m"); err != nil {
+		t.Fatalf("Set via flag.Value: %v", err)
+	}
+	if d.Duration != 90*time.Minute {
+		t.Errorf("Set(\"90m\") = %s, want 90m", d)
+	}
+}
+
+func TestDurationUnmarshalJSONBareNumberIsNanoseconds(t *testing.T) {
+	var d Duration
+	if err := d.UnmarshalJSON([]byte("1500000000")); err != nil {
+		t.Fatalf("UnmarshalJSON: %v", err)
+	}
+	if d.Duration != 1500*time.Millisecond {
+		t.Errorf("UnmarshalJSON(1500000000) = %s, want 1.5s", d)
+	}
+}
+
+func TestDurationYAMLBareIntegerIsNanoseconds(t *testing.T) {
+	unmarshalers := map[string]func([]byte, interface{}) error{
+		"yaml.v2": yamlv2.Unmarshal,
+		"yaml.v3": yamlv3.Unmarshal,
+	}
+	for name, unmarshal := range unmarshalers {
+		var got Duration
+		if err := unmarshal([]byte("30"), &got); err != nil {
+			t.Fatalf("%s Unmarshal: %v", name, err)
+		}
+		if got.Duration != 30*time.Nanosecond {
+			t.Errorf("%s bare integer = %s, want 30ns", name, got)
+		}
+	}
+}
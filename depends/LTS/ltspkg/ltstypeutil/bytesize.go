@@ -0,0 +1,154 @@
+package ltstypeutil
+
+import (
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/juju/errors"
+)
+
+// ByteSize is a wrapper of uint64 for memory/disk limits expressed in
+// config as human-readable strings like "512MB" or "2GiB".
+type ByteSize uint64
+
+// Decimal (SI) byte units.
+const (
+	Byte ByteSize = 1
+	KB            = Byte * 1000
+	MB            = KB * 1000
+	GB            = MB * 1000
+	TB            = GB * 1000
+)
+
+// Binary (IEC) byte units.
+const (
+	KiB = Byte * 1024
+	MiB = KiB * 1024
+	GiB = MiB * 1024
+	TiB = GiB * 1024
+)
+
+// byteSizeUnits lists the recognized suffixes, longest first so that, e.g.,
+// "KiB" is matched before "KB" would otherwise steal its "K".
+var byteSizeUnits = []struct {
+	suffix string
+	size   ByteSize
+}{
+	{"tib", TiB},
+	{"gib", GiB},
+	{"mib", MiB},
+	{"kib", KiB},
+	{"tb", TB},
+	{"gb", GB},
+	{"mb", MB},
+	{"kb", KB},
+	{"b", Byte},
+}
+
+var byteSizePattern = regexp.MustCompile(`^(\d+(?:\.\d+)?)\s*([a-zA-Z]*)$`)
+
+// ParseByteSize parses s, a bare byte count or a number followed by a
+// decimal (KB/MB/GB/TB) or binary (KiB/MiB/GiB/TiB) suffix, case
+// insensitive. It returns an error if s is malformed or the resulting
+// value overflows uint64.
+func ParseByteSize(s string) (ByteSize, error) {
+	s = strings.TrimSpace(s)
+	m := byteSizePattern.FindStringSubmatch(s)
+	if m == nil {
+		return 0, errors.Errorf("ltstypeutil: invalid byte size %q", s)
+	}
+
+	value, err := strconv.ParseFloat(m[1], 64)
+	if err != nil {
+		return 0, errors.Trace(err)
+	}
+
+	unit := ByteSize(Byte)
+	if suffix := strings.ToLower(m[2]); suffix != "" {
+		found := false
+		for _, u := range byteSizeUnits {
+			if suffix == u.suffix {
+				unit = u.size
+				found = true
+				break
+			}
+		}
+		if !found {
+			return 0, errors.Errorf("ltstypeutil: unknown byte size unit %q", m[2])
+		}
+	}
+
+	bytes := value * float64(unit)
+	if bytes < 0 || bytes > math.MaxUint64 {
+		return 0, errors.Errorf("ltstypeutil: byte size %q overflows uint64", s)
+	}
+	return ByteSize(bytes), nil
+}
+
+// String renders b using the largest decimal (SI) unit that keeps the
+// value at or above 1, e.g. "512MB", "1.5GB", "0B".
+func (b ByteSize) String() string {
+	switch {
+	case b >= TB:
+		return formatByteSize(b, TB, "TB")
+	case b >= GB:
+		return formatByteSize(b, GB, "GB")
+	case b >= MB:
+		return formatByteSize(b, MB, "MB")
+	case b >= KB:
+		return formatByteSize(b, KB, "KB")
+	default:
+		return strconv.FormatUint(uint64(b), 10) + "B"
+	}
+}
+
+func formatByteSize(b, unit ByteSize, suffix string) string {
+	return strconv.FormatFloat(float64(b)/float64(unit), 'f', -1, 64) + suffix
+}
+
+// MarshalJSON returns the byte size as a JSON string.
+func (b ByteSize) MarshalJSON() ([]byte, error) {
+	return []byte(`"` + b.String() + `"`), nil
+}
+
+// UnmarshalJSON parses a JSON string or bare number into the byte size.
+func (b *ByteSize) UnmarshalJSON(text []byte) error {
+	if len(text) == 0 {
+		return errors.New("empty byte size")
+	}
+	if text[0] == '"' {
+		s, err := strconv.Unquote(string(text))
+		if err != nil {
+			return errors.Trace(err)
+		}
+		parsed, err := ParseByteSize(s)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		*b = parsed
+		return nil
+	}
+	parsed, err := ParseByteSize(string(text))
+	if err != nil {
+		return errors.Trace(err)
+	}
+	*b = parsed
+	return nil
+}
+
+// MarshalText returns the byte size as a TOML string.
+func (b ByteSize) MarshalText() ([]byte, error) {
+	return []byte(b.String()), nil
+}
+
+// UnmarshalText parses a TOML string into the byte size.
+func (b *ByteSize) UnmarshalText(text []byte) error {
+	parsed, err := ParseByteSize(string(text))
+	if err != nil {
+		return errors.Trace(err)
+	}
+	*b = parsed
+	return nil
+}
@@ -0,0 +1,121 @@
+package ltstypeutil
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/juju/errors"
+)
+
+// Timestamp is a wrapper of time.Time for TOML, JSON and YAML.
+type Timestamp struct {
+	time.Time
+}
+
+// NewTimestamp creates a Timestamp from time.Time.
+func NewTimestamp(t time.Time) Timestamp {
+	return Timestamp{Time: t}
+}
+
+// unixEpochMillisThreshold distinguishes second-resolution from
+// millisecond-resolution Unix epoch integers: values below it are seconds,
+// values at or above it are milliseconds.
+const unixEpochMillisThreshold = 1e12
+
+// MarshalJSON returns the timestamp as an RFC3339Nano JSON string.
+func (t *Timestamp) MarshalJSON() ([]byte, error) {
+	return []byte(`"` + t.Format(time.RFC3339Nano) + `"`), nil
+}
+
+// UnmarshalJSON parses a JSON string or Unix-epoch number into the
+// timestamp.
+func (t *Timestamp) UnmarshalJSON(text []byte) error {
+	if len(text) == 0 {
+		return errors.New("empty timestamp")
+	}
+	if text[0] == '"' {
+		s, err := strconv.Unquote(string(text))
+		if err != nil {
+			return errors.Trace(err)
+		}
+		return t.parseString(s)
+	}
+	epoch, err := strconv.ParseInt(string(text), 10, 64)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	t.Time = timestampFromUnixEpoch(epoch)
+	return nil
+}
+
+// MarshalYAML returns the timestamp as an RFC3339Nano YAML string.
+func (t *Timestamp) MarshalYAML() (interface{}, error) {
+	return t.Format(time.RFC3339Nano), nil
+}
+
+// UnmarshalYAML parses a YAML string or Unix-epoch number into the
+// timestamp. The scalar is decoded into interface{} first because a bare
+// number also unmarshals successfully into a string target, which would
+// otherwise always take the string branch.
+func (t *Timestamp) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var raw interface{}
+	if err := unmarshal(&raw); err != nil {
+		return errors.Trace(err)
+	}
+
+	switch v := raw.(type) {
+	case string:
+		return t.parseString(v)
+	case int:
+		t.Time = timestampFromUnixEpoch(int64(v))
+		return nil
+	case int64:
+		t.Time = timestampFromUnixEpoch(v)
+		return nil
+	case uint64:
+		t.Time = timestampFromUnixEpoch(int64(v))
+		return nil
+	case float64:
+		t.Time = timestampFromUnixEpoch(int64(v))
+		return nil
+	default:
+		return errors.Errorf("ltstypeutil: cannot unmarshal %T into Timestamp", raw)
+	}
+}
+
+// MarshalText returns the timestamp as an RFC3339Nano string, for TOML.
+func (t *Timestamp) MarshalText() ([]byte, error) {
+	return []byte(t.Format(time.RFC3339Nano)), nil
+}
+
+// UnmarshalText parses an RFC3339, RFC3339Nano or Unix-epoch TOML string
+// into the timestamp.
+func (t *Timestamp) UnmarshalText(text []byte) error {
+	return t.parseString(string(text))
+}
+
+// parseString parses s as RFC3339Nano, RFC3339, or a Unix-epoch integer.
+func (t *Timestamp) parseString(s string) error {
+	if epoch, err := strconv.ParseInt(s, 10, 64); err == nil {
+		t.Time = timestampFromUnixEpoch(epoch)
+		return nil
+	}
+	parsed, err := time.Parse(time.RFC3339Nano, s)
+	if err != nil {
+		parsed, err = time.Parse(time.RFC3339, s)
+		if err != nil {
+			return errors.Trace(err)
+		}
+	}
+	t.Time = parsed
+	return nil
+}
+
+// timestampFromUnixEpoch interprets epoch as Unix seconds if it is below
+// unixEpochMillisThreshold, and as Unix milliseconds otherwise.
+func timestampFromUnixEpoch(epoch int64) time.Time {
+	if epoch < unixEpochMillisThreshold {
+		return time.Unix(epoch, 0)
+	}
+	return time.Unix(0, epoch*int64(time.Millisecond))
+}
@@ -0,0 +1,86 @@
+package ltstypeutil
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestParseByteSize(t *testing.T) {
+	cases := []struct {
+		in   string
+		want ByteSize
+	}{
+		{"0", 0},
+		{"1024", 1024},
+		{"10mb", 10 * MB},
+		{"10MB", 10 * MB},
+		{"2GiB", 2 * GiB},
+		{"1.5GB", ByteSize(1.5 * float64(GB))},
+		{"512KiB", 512 * KiB},
+	}
+	for _, c := range cases {
+		got, err := ParseByteSize(c.in)
+		if err != nil {
+			t.Fatalf("ParseByteSize(%q): %v", c.in, err)
+		}
+		if got != c.want {
+			t.Errorf("ParseByteSize(%q) = %d, want %d", c.in, got, c.want)
+		}
+	}
+}
+
+func TestParseByteSizeErrors(t *testing.T) {
+	cases := []string{"", "abc", "10xb", "99999999999999999999"}
+	for _, in := range cases {
+		if _, err := ParseByteSize(in); err == nil {
+			t.Errorf("ParseByteSize(%q): expected an error, got nil", in)
+		}
+	}
+}
+
+func TestByteSizeString(t *testing.T) {
+	cases := []struct {
+		in   ByteSize
+		want string
+	}{
+		{0, "0B"},
+		{512, "512B"},
+		{10 * MB, "10MB"},
+		{ByteSize(1.5 * float64(GB)), "1.5GB"},
+	}
+	for _, c := range cases {
+		if got := c.in.String(); got != c.want {
+			t.Errorf("ByteSize(%d).String() = %s, want %s", c.in, got, c.want)
+		}
+	}
+}
+
+func TestByteSizeJSONRoundTrip(t *testing.T) {
+	b := 10 * MB
+	data, err := json.Marshal(b)
+	if err != nil {
+		t.Fatalf("Marshal: %v", err)
+	}
+	var got ByteSize
+	if err := json.Unmarshal(data, &got); err != nil {
+		t.Fatalf("Unmarshal(%s): %v", data, err)
+	}
+	if got != b {
+		t.Errorf("round trip mismatch: got %d, want %d", got, b)
+	}
+}
+
+func TestByteSizeTextRoundTrip(t *testing.T) {
+	b := 2 * GiB
+	data, err := b.MarshalText()
+	if err != nil {
+		t.Fatalf("MarshalText: %v", err)
+	}
+	var got ByteSize
+	if err := got.UnmarshalText(data); err != nil {
+		t.Fatalf("UnmarshalText(%s): %v", data, err)
+	}
+	if got != b {
+		t.Errorf("round trip mismatch: got %d, want %d", got, b)
+	}
+}
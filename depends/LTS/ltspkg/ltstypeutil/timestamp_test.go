@@ -0,0 +1,120 @@
+package ltstypeutil
+
+import (
+	"encoding/json"
+	"strconv"
+	"testing"
+	"time"
+
+	yamlv2 "gopkg.in/yaml.v2"
+	yamlv3 "gopkg.in/yaml.v3"
+)
+
+func TestTimestampJSONRoundTrip(t *testing.T) {
+	ts := NewTimestamp(time.Date(2026, 7, 28, 12, 30, 0, 123456789, time.UTC))
+	data, err := json.Marshal(&ts)
+	if err != nil {
+		t.Fatalf("Marshal: %v", err)
+	}
+	var got Timestamp
+	if err := json.Unmarshal(data, &got); err != nil {
+		t.Fatalf("Unmarshal(%s): %v", data, err)
+	}
+	if !got.Time.Equal(ts.Time) {
+		t.Errorf("round trip mismatch: got %s, want %s", got.Time, ts.Time)
+	}
+}
+
+func TestTimestampTextRoundTrip(t *testing.T) {
+	ts := NewTimestamp(time.Date(2026, 7, 28, 12, 30, 0, 123456789, time.UTC))
+	data, err := ts.MarshalText()
+	if err != nil {
+		t.Fatalf("MarshalText: %v", err)
+	}
+	var got Timestamp
+	if err := got.UnmarshalText(data); err != nil {
+		t.Fatalf("UnmarshalText(%s): %v", data, err)
+	}
+	if !got.Time.Equal(ts.Time) {
+		t.Errorf("round trip mismatch: got %s, want %s", got.Time, ts.Time)
+	}
+}
+
+func TestTimestampYAMLRoundTrip(t *testing.T) {
+	ts := NewTimestamp(time.Date(2026, 7, 28, 12, 30, 0, 123456789, time.UTC))
+
+	marshalers := map[string]func(interface{}) ([]byte, error){
+		"yaml.v2": yamlv2.Marshal,
+		"yaml.v3": yamlv3.Marshal,
+	}
+	unmarshalers := map[string]func([]byte, interface{}) error{
+		"yaml.v2": yamlv2.Unmarshal,
+		"yaml.v3": yamlv3.Unmarshal,
+	}
+
+	for name, marshal := range marshalers {
+		unmarshal := unmarshalers[name]
+		data, err := marshal(&ts)
+		if err != nil {
+			t.Fatalf("%s Marshal: %v", name, err)
+		}
+		var got Timestamp
+		if err := unmarshal(data, &got); err != nil {
+			t.Fatalf("%s Unmarshal(%s): %v", name, data, err)
+		}
+		if !got.Time.Equal(ts.Time) {
+			t.Errorf("%s round trip mismatch: got %s, want %s", name, got.Time, ts.Time)
+		}
+	}
+}
+
+func TestTimestampParsesRFC3339AndRFC3339Nano(t *testing.T) {
+	cases := []string{
+		"2026-07-28T12:30:00Z",
+		"2026-07-28T12:30:00.123456789Z",
+	}
+	for _, s := range cases {
+		var got Timestamp
+		if err := got.UnmarshalText([]byte(s)); err != nil {
+			t.Fatalf("UnmarshalText(%s): %v", s, err)
+		}
+		want, err := time.Parse(time.RFC3339Nano, s)
+		if err != nil {
+			t.Fatalf("time.Parse(%s): %v", s, err)
+		}
+		if !got.Time.Equal(want) {
+			t.Errorf("UnmarshalText(%s) = %s, want %s", s, got.Time, want)
+		}
+	}
+}
+
+func TestTimestampUnixEpochThresholds(t *testing.T) {
+	cases := []struct {
+		name  string
+		epoch int64
+		want  time.Time
+	}{
+		{"seconds", 1700000000, time.Unix(1700000000, 0)},
+		{"milliseconds", 1700000000123, time.Unix(0, 1700000000123*int64(time.Millisecond))},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			var jsonGot Timestamp
+			data := []byte(strconv.FormatInt(c.epoch, 10))
+			if err := jsonGot.UnmarshalJSON(data); err != nil {
+				t.Fatalf("UnmarshalJSON(%s): %v", data, err)
+			}
+			if !jsonGot.Time.Equal(c.want) {
+				t.Errorf("UnmarshalJSON(%s) = %s, want %s", data, jsonGot.Time, c.want)
+			}
+
+			var yamlGot Timestamp
+			if err := yamlv2.Unmarshal(data, &yamlGot); err != nil {
+				t.Fatalf("yaml.v2 Unmarshal(%s): %v", data, err)
+			}
+			if !yamlGot.Time.Equal(c.want) {
+				t.Errorf("yaml.v2 Unmarshal(%s) = %s, want %s", data, yamlGot.Time, c.want)
+			}
+		})
+	}
+}
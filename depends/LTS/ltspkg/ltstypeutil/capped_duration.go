@@ -0,0 +1,53 @@
+package ltstypeutil
+
+import (
+	"time"
+
+	"github.com/juju/errors"
+)
+
+// CappedDuration is a Duration that rejects out-of-range values as soon as
+// it is decoded, rather than requiring a separate validation pass. Because
+// UnmarshalJSON/UnmarshalText are invoked by the decoder rather than by
+// application code, the bounds can't be passed in as constructor
+// arguments at decode time — they're instead stored on the struct itself
+// by NewCappedDuration before the value is ever decoded into, and survive
+// across Unmarshal calls since Unmarshal only ever overwrites the value
+// field.
+type CappedDuration struct {
+	Duration
+	min, max time.Duration
+}
+
+// NewCappedDuration returns a CappedDuration bounded to [min, max], with
+// its value defaulting to min.
+func NewCappedDuration(min, max time.Duration) CappedDuration {
+	return CappedDuration{Duration: NewDuration(min), min: min, max: max}
+}
+
+// UnmarshalJSON decodes text into d's Duration, then rejects the result if
+// it falls outside the bounds set by NewCappedDuration.
+func (d *CappedDuration) UnmarshalJSON(text []byte) error {
+	if err := d.Duration.UnmarshalJSON(text); err != nil {
+		return errors.Trace(err)
+	}
+	return errors.Trace(d.validate())
+}
+
+// UnmarshalText decodes text into d's Duration, then rejects the result if
+// it falls outside the bounds set by NewCappedDuration.
+func (d *CappedDuration) UnmarshalText(text []byte) error {
+	if err := d.Duration.UnmarshalText(text); err != nil {
+		return errors.Trace(err)
+	}
+	return errors.Trace(d.validate())
+}
+
+// validate returns an error naming d's bounds if its value falls outside
+// [min, max].
+func (d *CappedDuration) validate() error {
+	if d.Duration.Duration < d.min || d.Duration.Duration > d.max {
+		return errors.Errorf("ltstypeutil: duration %s is outside the allowed range [%s, %s]", d.Duration, d.min, d.max)
+	}
+	return nil
+}
@@ -0,0 +1,30 @@
+package ltstypeutil
+
+import (
+	"testing"
+	"time"
+)
+
+func TestCappedDurationInRange(t *testing.T) {
+	d := NewCappedDuration(time.Second, time.Minute)
+	if err := d.UnmarshalText([]byte("30s")); err != nil {
+		t.Fatalf("UnmarshalText: %v", err)
+	}
+	if d.Duration.Duration != 30*time.Second {
+		t.Errorf("got %s, want 30s", d.Duration)
+	}
+}
+
+func TestCappedDurationBelowMin(t *testing.T) {
+	d := NewCappedDuration(time.Second, time.Minute)
+	if err := d.UnmarshalText([]byte("100ms")); err == nil {
+		t.Fatal("UnmarshalText: expected an error for a value below the minimum, got nil")
+	}
+}
+
+func TestCappedDurationAboveMax(t *testing.T) {
+	d := NewCappedDuration(time.Second, time.Minute)
+	if err := d.UnmarshalText([]byte("2m")); err == nil {
+		t.Fatal("UnmarshalText: expected an error for a value above the maximum, got nil")
+	}
+}
@@ -0,0 +1,53 @@
+package ltstypeutil
+
+import "time"
+
+// Clock abstracts time.Now for code that needs to compute deadlines
+// deterministically in tests.
+type Clock interface {
+	Now() time.Time
+}
+
+// realClock implements Clock with the real wall clock.
+type realClock struct{}
+
+// Now returns time.Now().
+func (realClock) Now() time.Time {
+	return time.Now()
+}
+
+// RealClock is the default Clock, backed by time.Now.
+var RealClock Clock = realClock{}
+
+// FakeClock is a Clock pinned to a fixed time, for tests that need
+// deterministic deadlines.
+type FakeClock struct {
+	now time.Time
+}
+
+// NewFakeClock returns a FakeClock pinned to now.
+func NewFakeClock(now time.Time) *FakeClock {
+	return &FakeClock{now: now}
+}
+
+// Now returns the pinned time.
+func (c *FakeClock) Now() time.Time {
+	return c.now
+}
+
+// Set moves the pinned time to now.
+func (c *FakeClock) Set(now time.Time) {
+	c.now = now
+}
+
+// Advance moves the pinned time forward by d (which may be negative).
+func (c *FakeClock) Advance(d time.Duration) {
+	c.now = c.now.Add(d)
+}
+
+// Deadline returns clock.Now() plus d. Passing a negative d, or the zero
+// Duration, is valid and returns a deadline in the past or equal to now
+// respectively.
+func (d Duration) Deadline(clock Clock) time.Time {
+	return clock.Now().Add(d.Duration)
+}
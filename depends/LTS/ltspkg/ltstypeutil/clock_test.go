@@ -0,0 +1,36 @@
+package ltstypeutil
+
+import (
+	"testing"
+	"time"
+)
+
+func TestDurationDeadlineZero(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	clock := NewFakeClock(now)
+
+	deadline := ZeroDuration.Deadline(clock)
+	if !deadline.Equal(now) {
+		t.Errorf("Deadline() = %s, want %s", deadline, now)
+	}
+}
+
+func TestDurationDeadlineNegative(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	clock := NewFakeClock(now)
+
+	deadline := NewDuration(-time.Hour).Deadline(clock)
+	want := now.Add(-time.Hour)
+	if !deadline.Equal(want) {
+		t.Errorf("Deadline() = %s, want %s (in the past)", deadline, want)
+	}
+}
+
+func TestFakeClockAdvance(t *testing.T) {
+	clock := NewFakeClock(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC))
+	clock.Advance(time.Hour)
+	want := time.Date(2026, 1, 1, 1, 0, 0, 0, time.UTC)
+	if !clock.Now().Equal(want) {
+		t.Errorf("Now() = %s, want %s", clock.Now(), want)
+	}
+}
@@ -0,0 +1,74 @@
+package ltstypeutil
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/juju/errors"
+)
+
+// dateLayout is the calendar-only layout Date reads and writes.
+const dateLayout = "2006-01-02"
+
+// Date is a wrapper of time.Time for calendar config fields that carry no
+// time component, e.g. `"2024-01-15"`. Values are stored at midnight UTC.
+type Date struct {
+	time.Time
+}
+
+// NewDate creates a Date from t, truncated to midnight UTC.
+func NewDate(t time.Time) Date {
+	y, m, d := t.Date()
+	return Date{Time: time.Date(y, m, d, 0, 0, 0, 0, time.UTC)}
+}
+
+// Before reports whether d is before other.
+func (d Date) Before(other Date) bool {
+	return d.Time.Before(other.Time)
+}
+
+// After reports whether d is after other.
+func (d Date) After(other Date) bool {
+	return d.Time.After(other.Time)
+}
+
+// MarshalJSON returns the date in "2006-01-02" form, or JSON null for the
+// zero value.
+func (d Date) MarshalJSON() ([]byte, error) {
+	if d.Time.IsZero() {
+		return []byte("null"), nil
+	}
+	return []byte(strconv.Quote(d.Time.Format(dateLayout))), nil
+}
+
+// UnmarshalJSON parses a JSON "2006-01-02" string into the date. A JSON
+// null yields the zero value.
+func (d *Date) UnmarshalJSON(text []byte) error {
+	if len(text) == 0 {
+		return errors.New("empty date")
+	}
+	if string(text) == "null" {
+		d.Time = time.Time{}
+		return nil
+	}
+	s, err := strconv.Unquote(string(text))
+	if err != nil {
+		return errors.Trace(err)
+	}
+	return d.UnmarshalText([]byte(s))
+}
+
+// MarshalText returns the date in "2006-01-02" form, for TOML.
+func (d Date) MarshalText() ([]byte, error) {
+	return []byte(d.Time.Format(dateLayout)), nil
+}
+
+// UnmarshalText parses a "2006-01-02" string into the date.
+func (d *Date) UnmarshalText(text []byte) error {
+	parsed, err := time.Parse(dateLayout, string(text))
+	if err != nil {
+		return errors.Annotatef(err, "ltstypeutil: invalid date %q", text)
+	}
+	d.Time = parsed
+	return nil
+}
@@ -0,0 +1,56 @@
+package ltstypeutil
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestDateJSONRoundTrip(t *testing.T) {
+	d := NewDate(time.Date(2024, 1, 15, 13, 45, 0, 0, time.UTC))
+	data, err := json.Marshal(&d)
+	if err != nil {
+		t.Fatalf("Marshal: %v", err)
+	}
+	if string(data) != `"2024-01-15"` {
+		t.Errorf("Marshal = %s, want \"2024-01-15\"", data)
+	}
+
+	var got Date
+	if err := json.Unmarshal(data, &got); err != nil {
+		t.Fatalf("Unmarshal(%s): %v", data, err)
+	}
+	if !got.Time.Equal(d.Time) {
+		t.Errorf("round trip mismatch: got %s, want %s", got.Time, d.Time)
+	}
+}
+
+func TestDateZeroValueMarshalsAsNull(t *testing.T) {
+	var d Date
+	data, err := json.Marshal(&d)
+	if err != nil {
+		t.Fatalf("Marshal: %v", err)
+	}
+	if string(data) != "null" {
+		t.Errorf("Marshal(zero) = %s, want null", data)
+	}
+}
+
+func TestDateUnmarshalJSONInvalid(t *testing.T) {
+	var d Date
+	if err := json.Unmarshal([]byte(`"2024-13-40"`), &d); err == nil {
+		t.Fatal(`Unmarshal("2024-13-40"): expected an error, got nil`)
+	}
+}
+
+func TestDateBeforeAfter(t *testing.T) {
+	earlier := NewDate(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+	later := NewDate(time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC))
+
+	if !earlier.Before(later) {
+		t.Error("Before: expected earlier to be before later")
+	}
+	if !later.After(earlier) {
+		t.Error("After: expected later to be after earlier")
+	}
+}
@@ -0,0 +1,146 @@
+package ltstypeutil
+
+// Describer is implemented by config types that can describe their
+// accepted textual formats, so a doc generator can list them without
+// hand-maintained documentation drifting out of sync with the code.
+type Describer interface {
+	// FormatHelp returns a short, stable description of the accepted
+	// formats, e.g. "duration string, e.g. 1h30m, 500ms".
+	FormatHelp() string
+}
+
+// formatRegistry maps a type name to its FormatHelp output, populated by
+// init functions alongside each Describer implementation.
+var formatRegistry = map[string]string{}
+
+// registerFormat records name's FormatHelp output in the registry.
+func registerFormat(name string, d Describer) {
+	formatRegistry[name] = d.FormatHelp()
+}
+
+// RegisteredFormats returns a copy of the type-name-to-format-help
+// registry, for a doc generator to walk.
+func RegisteredFormats() map[string]string {
+	out := make(map[string]string, len(formatRegistry))
+	for name, help := range formatRegistry {
+		out[name] = help
+	}
+	return out
+}
+
+func init() {
+	registerFormat("Duration", Duration{})
+	registerFormat("ByteSize", ByteSize(0))
+	registerFormat("Percentage", Percentage(0))
+	registerFormat("Bool", Bool(false))
+	registerFormat("StringSet", StringSet{})
+	registerFormat("Enum", Enum{})
+	registerFormat("Date", Date{})
+	registerFormat("Timestamp", Timestamp{})
+	registerFormat("URL", URL{})
+	registerFormat("Regexp", Regexp{})
+	registerFormat("TimeOfDay", TimeOfDay{})
+	registerFormat("DurationSlice", DurationSlice{})
+	registerFormat("RateLimit", RateLimit{})
+	registerFormat("Quantity", Quantity{})
+	registerFormat("JitterDuration", JitterDuration{})
+	registerFormat("MillisDuration", MillisDuration{})
+	registerFormat("FloatSecondsDuration", FloatSecondsDuration{})
+	registerFormat("CappedDuration", CappedDuration{})
+	registerFormat("OptionalDuration", OptionalDuration{})
+}
+
+// FormatHelp describes the accepted Duration formats.
+func (d Duration) FormatHelp() string {
+	return "duration string, e.g. 1h30m, 500ms"
+}
+
+// FormatHelp describes the accepted ByteSize formats.
+func (s ByteSize) FormatHelp() string {
+	return "byte size string, e.g. 10MB, 1GiB"
+}
+
+// FormatHelp describes the accepted Percentage formats.
+func (p Percentage) FormatHelp() string {
+	return "percentage string or fraction, e.g. 50%, 0.5"
+}
+
+// FormatHelp describes the accepted Bool formats.
+func (b Bool) FormatHelp() string {
+	return "boolean, e.g. true, yes, on"
+}
+
+// FormatHelp describes the accepted StringSet formats.
+func (s StringSet) FormatHelp() string {
+	return "array of unique strings, e.g. [\"a\",\"b\"]"
+}
+
+// FormatHelp describes the accepted Enum formats.
+func (e Enum) FormatHelp() string {
+	return "one of a configured set of case-insensitive string values"
+}
+
+// FormatHelp describes the accepted Date formats.
+func (d Date) FormatHelp() string {
+	return "calendar date string, e.g. 2024-01-15"
+}
+
+// FormatHelp describes the accepted Timestamp formats.
+func (t Timestamp) FormatHelp() string {
+	return "RFC3339Nano timestamp string, or a Unix-epoch integer"
+}
+
+// FormatHelp describes the accepted URL formats.
+func (u URL) FormatHelp() string {
+	return "URL string, e.g. https://example.com/path"
+}
+
+// FormatHelp describes the accepted Regexp formats.
+func (r Regexp) FormatHelp() string {
+	return "regular expression pattern string"
+}
+
+// FormatHelp describes the accepted TimeOfDay formats.
+func (t TimeOfDay) FormatHelp() string {
+	return "time-of-day string, e.g. 15:04 or 15:04:05"
+}
+
+// FormatHelp describes the accepted DurationSlice formats.
+func (s DurationSlice) FormatHelp() string {
+	return "array of duration strings, e.g. [\"1s\",\"2s\",\"5s\"]"
+}
+
+// FormatHelp describes the accepted RateLimit formats.
+func (r RateLimit) FormatHelp() string {
+	return "rate string, e.g. 100/s or 1000/1m"
+}
+
+// FormatHelp describes the accepted Quantity formats.
+func (q Quantity) FormatHelp() string {
+	return "number with an optional unit string, e.g. \"5 requests\""
+}
+
+// FormatHelp describes the accepted JitterDuration formats.
+func (j JitterDuration) FormatHelp() string {
+	return "duration string with an optional symmetric jitter bound, e.g. 30s±5s"
+}
+
+// FormatHelp describes the accepted MillisDuration formats.
+func (d MillisDuration) FormatHelp() string {
+	return "integer count of milliseconds, or a quoted duration string like \"5s\""
+}
+
+// FormatHelp describes the accepted FloatSecondsDuration formats.
+func (d FloatSecondsDuration) FormatHelp() string {
+	return "fractional number of seconds, or a quoted duration string like \"1500ms\""
+}
+
+// FormatHelp describes the accepted CappedDuration formats.
+func (d CappedDuration) FormatHelp() string {
+	return "duration string, e.g. 1h30m, 500ms (validated against a configured min/max)"
+}
+
+// FormatHelp describes the accepted OptionalDuration formats.
+func (d OptionalDuration) FormatHelp() string {
+	return "duration string, e.g. 1h30m, 500ms, or JSON null to leave it unset"
+}
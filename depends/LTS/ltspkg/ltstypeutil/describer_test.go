@@ -0,0 +1,33 @@
+package ltstypeutil
+
+import "testing"
+
+func TestDurationFormatHelpIsStable(t *testing.T) {
+	want := "duration string, e.g. 1h30m, 500ms"
+	if got := NewDuration(0).FormatHelp(); got != want {
+		t.Errorf("FormatHelp() = %q, want %q", got, want)
+	}
+}
+
+func TestRegisteredFormatsIncludesDuration(t *testing.T) {
+	formats := RegisteredFormats()
+	help, ok := formats["Duration"]
+	if !ok || help == "" {
+		t.Errorf("RegisteredFormats()[\"Duration\"] = %q, %v, want a non-empty entry", help, ok)
+	}
+}
+
+func TestRegisteredFormatsCoversAllConfigTypes(t *testing.T) {
+	want := []string{
+		"Duration", "ByteSize", "Percentage", "Bool", "StringSet", "Enum",
+		"Date", "Timestamp", "URL", "Regexp", "TimeOfDay", "DurationSlice",
+		"RateLimit", "Quantity", "JitterDuration", "MillisDuration",
+		"FloatSecondsDuration", "CappedDuration", "OptionalDuration",
+	}
+	formats := RegisteredFormats()
+	for _, name := range want {
+		if help, ok := formats[name]; !ok || help == "" {
+			t.Errorf("RegisteredFormats()[%q] = %q, %v, want a non-empty entry", name, help, ok)
+		}
+	}
+}
@@ -0,0 +1,59 @@
+package ltstypeutil
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/csv"
+	"testing"
+	"time"
+)
+
+// TestDurationCSVRoundTrip exercises the encoding.TextMarshaler /
+// TextUnmarshaler contract end-to-end through encoding/csv, the same
+// contract gocarina/gocsv relies on for struct-tagged CSV columns.
+func TestDurationCSVRoundTrip(t *testing.T) {
+	rows := []struct {
+		Name     string
+		Duration Duration
+	}{
+		{"job-a", NewDuration(90 * time.Second)},
+		{"job-b", NewDuration(2 * time.Hour)},
+	}
+
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	for _, row := range rows {
+		var m encoding.TextMarshaler = row.Duration
+		text, err := m.MarshalText()
+		if err != nil {
+			t.Fatalf("MarshalText(%s): %v", row.Name, err)
+		}
+		if err := w.Write([]string{row.Name, string(text)}); err != nil {
+			t.Fatalf("Write(%s): %v", row.Name, err)
+		}
+	}
+	w.Flush()
+	if err := w.Error(); err != nil {
+		t.Fatalf("csv writer: %v", err)
+	}
+
+	if got := buf.String(); got != "job-a,1m30s\njob-b,2h0m0s\n" {
+		t.Fatalf("csv = %q", got)
+	}
+
+	r := csv.NewReader(&buf)
+	records, err := r.ReadAll()
+	if err != nil {
+		t.Fatalf("ReadAll: %v", err)
+	}
+	for i, record := range records {
+		var d Duration
+		var u encoding.TextUnmarshaler = &d
+		if err := u.UnmarshalText([]byte(record[1])); err != nil {
+			t.Fatalf("UnmarshalText(%s): %v", record[0], err)
+		}
+		if d.Duration != rows[i].Duration.Duration {
+			t.Errorf("row %d: got %s, want %s", i, d, rows[i].Duration)
+		}
+	}
+}
@@ -0,0 +1,26 @@
+package ltstypeutil
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Sentinel errors for Duration parse failures, so callers can distinguish
+// failure categories with errors.Is rather than matching on message text.
+// UnmarshalJSON and UnmarshalText return errors wrapping one of these.
+var (
+	// ErrEmptyDuration is returned when the input to parse is empty.
+	ErrEmptyDuration = errors.New("ltstypeutil: empty duration")
+	// ErrInvalidDuration is returned when the input is non-empty but does
+	// not parse as a duration.
+	ErrInvalidDuration = errors.New("ltstypeutil: invalid duration syntax")
+	// ErrDurationOverflow is returned when the input parses but does not
+	// fit in a time.Duration's int64 nanosecond range.
+	ErrDurationOverflow = errors.New("ltstypeutil: duration too large")
+)
+
+// wrapDurationError annotates sentinel with detail while keeping it
+// matchable by errors.Is against sentinel.
+func wrapDurationError(sentinel error, detail string) error {
+	return fmt.Errorf("%w: %s", sentinel, detail)
+}
@@ -0,0 +1,47 @@
+package ltstypeutil
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestDurationUnmarshalJSONErrorCategories(t *testing.T) {
+	cases := []struct {
+		name  string
+		input string
+		want  error
+	}{
+		{"empty", "", ErrEmptyDuration},
+		{"bad syntax", `"not-a-duration"`, ErrInvalidDuration},
+		{"bad ns", "not-a-number", ErrInvalidDuration},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			var d Duration
+			err := d.UnmarshalJSON([]byte(c.input))
+			if err == nil {
+				t.Fatal("UnmarshalJSON: expected an error, got nil")
+			}
+			if !errors.Is(err, c.want) {
+				t.Errorf("UnmarshalJSON(%q) error = %v, want errors.Is match against %v", c.input, err, c.want)
+			}
+		})
+	}
+}
+
+func TestDurationUnmarshalTextErrorCategories(t *testing.T) {
+	var d Duration
+	if err := d.UnmarshalText([]byte("")); !errors.Is(err, ErrEmptyDuration) {
+		t.Errorf("UnmarshalText(\"\") error = %v, want errors.Is match against ErrEmptyDuration", err)
+	}
+	if err := d.UnmarshalText([]byte("not-a-duration")); !errors.Is(err, ErrInvalidDuration) {
+		t.Errorf("UnmarshalText(\"not-a-duration\") error = %v, want errors.Is match against ErrInvalidDuration", err)
+	}
+}
+
+func TestParseExtendedDurationOverflowErrorIs(t *testing.T) {
+	_, err := ParseExtendedDuration("1e30")
+	if !errors.Is(err, ErrDurationOverflow) {
+		t.Errorf("ParseExtendedDuration(\"1e30\") error = %v, want errors.Is match against ErrDurationOverflow", err)
+	}
+}
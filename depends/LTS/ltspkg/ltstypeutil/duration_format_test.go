@@ -0,0 +1,57 @@
+package ltstypeutil
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestDurationFormatClockStyle(t *testing.T) {
+	d := NewDuration(time.Hour + 30*time.Minute)
+	got, err := d.Format("%H:%M:%S")
+	if err != nil {
+		t.Fatalf("Format: %v", err)
+	}
+	if got != "01:30:00" {
+		t.Errorf("Format(%%H:%%M:%%S) = %q, want \"01:30:00\"", got)
+	}
+}
+
+func TestDurationFormatDays(t *testing.T) {
+	d := NewDuration(50 * time.Hour)
+	got, err := d.Format("%d days")
+	if err != nil {
+		t.Fatalf("Format: %v", err)
+	}
+	if got != "2 days" {
+		t.Errorf("Format(%%d days) = %q, want \"2 days\"", got)
+	}
+}
+
+func TestDurationFormatUnknownVerb(t *testing.T) {
+	if _, err := NewDuration(time.Second).Format("%Q"); err == nil {
+		t.Error("Format(%Q): expected an error, got nil")
+	}
+}
+
+func TestDurationFormatNegative(t *testing.T) {
+	d := NewDuration(-90 * time.Minute)
+	got, err := d.Format("%H:%M:%S")
+	if err != nil {
+		t.Fatalf("Format: %v", err)
+	}
+	if got != "-01:30:00" {
+		t.Errorf("Format(%%H:%%M:%%S) = %q, want \"-01:30:00\"", got)
+	}
+}
+
+func TestDurationFormatMinInt64(t *testing.T) {
+	d := NewDuration(math.MinInt64)
+	got, err := d.Format("%d days %H:%M:%S")
+	if err != nil {
+		t.Fatalf("Format: %v", err)
+	}
+	if got != "-106751 days 23:47:16" {
+		t.Errorf("Format(%%d days %%H:%%M:%%S) on MinInt64 = %q, want \"-106751 days 23:47:16\"", got)
+	}
+}
@@ -0,0 +1,49 @@
+package ltstypeutil
+
+import "testing"
+
+// FuzzUnmarshalJSON feeds arbitrary bytes to Duration.UnmarshalJSON,
+// asserting it never panics and that any value it does accept re-marshals
+// to something that parses back to an equal value.
+func FuzzUnmarshalJSON(f *testing.F) {
+	seeds := []string{
+		``,
+		`""`,
+		`null`,
+		`0`,
+		`"0s"`,
+		`"5s"`,
+		`"-5s"`,
+		`"1h30m"`,
+		`"garbage"`,
+		`5000000000`,
+		`-5000000000`,
+		`"`,
+		`"é"`,
+		`"  5s  "`,
+		`１２３`,
+	}
+	for _, s := range seeds {
+		f.Add(s)
+	}
+
+	f.Fuzz(func(t *testing.T, s string) {
+		var d Duration
+		if err := d.UnmarshalJSON([]byte(s)); err != nil {
+			return
+		}
+
+		data, err := d.MarshalJSON()
+		if err != nil {
+			t.Fatalf("MarshalJSON after successful UnmarshalJSON(%q): %v", s, err)
+		}
+
+		var roundTripped Duration
+		if err := roundTripped.UnmarshalJSON(data); err != nil {
+			t.Fatalf("UnmarshalJSON(%q) round trip: %v", data, err)
+		}
+		if roundTripped.Duration != d.Duration {
+			t.Fatalf("round trip mismatch for input %q: got %s, want %s", s, roundTripped, d)
+		}
+	})
+}
@@ -0,0 +1,62 @@
+package ltstypeutil
+
+import (
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/juju/errors"
+)
+
+// DurationSlice is a list of durations for config fields like a sequence
+// of backoff steps, e.g. `["1s","2s","5s"]`.
+type DurationSlice []Duration
+
+// Total returns the sum of all durations in the slice.
+func (s DurationSlice) Total() time.Duration {
+	var total time.Duration
+	for _, d := range s {
+		total += d.Duration
+	}
+	return total
+}
+
+// UnmarshalJSON reads a JSON array of duration strings. An empty array
+// yields an empty, non-nil slice.
+func (s *DurationSlice) UnmarshalJSON(text []byte) error {
+	var raw []json.RawMessage
+	if err := json.Unmarshal(text, &raw); err != nil {
+		return errors.Trace(err)
+	}
+
+	out := make(DurationSlice, len(raw))
+	for i, r := range raw {
+		if err := out[i].UnmarshalJSON(r); err != nil {
+			return errors.Annotatef(err, "element %d", i)
+		}
+	}
+	*s = out
+	return nil
+}
+
+// UnmarshalText reads a comma-separated string like "1s,2s,5s", for
+// env-var friendliness. An empty string yields an empty, non-nil slice.
+func (s *DurationSlice) UnmarshalText(text []byte) error {
+	str := strings.TrimSpace(string(text))
+	if str == "" {
+		*s = DurationSlice{}
+		return nil
+	}
+
+	parts := strings.Split(str, ",")
+	out := make(DurationSlice, len(parts))
+	for i, part := range parts {
+		duration, err := ParseExtendedDuration(strings.TrimSpace(part))
+		if err != nil {
+			return errors.Annotatef(err, "element %d", i)
+		}
+		out[i] = NewDuration(duration)
+	}
+	*s = out
+	return nil
+}
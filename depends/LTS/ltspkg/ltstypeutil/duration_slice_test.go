@@ -0,0 +1,59 @@
+package ltstypeutil
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestDurationSliceUnmarshalJSON(t *testing.T) {
+	var s DurationSlice
+	if err := json.Unmarshal([]byte(`["1s","2s","5s"]`), &s); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+	if len(s) != 3 || s.Total() != 8*time.Second {
+		t.Errorf("got %v, Total()=%s, want 3 elements totalling 8s", s, s.Total())
+	}
+}
+
+func TestDurationSliceUnmarshalJSONEmpty(t *testing.T) {
+	var s DurationSlice
+	if err := json.Unmarshal([]byte(`[]`), &s); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+	if s == nil || len(s) != 0 {
+		t.Errorf("got %v, want empty non-nil slice", s)
+	}
+}
+
+func TestDurationSliceUnmarshalJSONNamesBadIndex(t *testing.T) {
+	var s DurationSlice
+	err := json.Unmarshal([]byte(`["1s","garbage","5s"]`), &s)
+	if err == nil {
+		t.Fatal("Unmarshal: expected an error, got nil")
+	}
+	if !strings.Contains(err.Error(), "element 1") {
+		t.Errorf("error %q does not name the offending index", err)
+	}
+}
+
+func TestDurationSliceUnmarshalText(t *testing.T) {
+	var s DurationSlice
+	if err := s.UnmarshalText([]byte("1s,2s,5s")); err != nil {
+		t.Fatalf("UnmarshalText: %v", err)
+	}
+	if len(s) != 3 || s.Total() != 8*time.Second {
+		t.Errorf("got %v, want 3 elements totalling 8s", s)
+	}
+}
+
+func TestDurationSliceUnmarshalTextEmpty(t *testing.T) {
+	var s DurationSlice
+	if err := s.UnmarshalText([]byte("")); err != nil {
+		t.Fatalf("UnmarshalText: %v", err)
+	}
+	if s == nil || len(s) != 0 {
+		t.Errorf("got %v, want empty non-nil slice", s)
+	}
+}
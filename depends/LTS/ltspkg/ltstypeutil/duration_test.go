@@ -0,0 +1,811 @@
+package ltstypeutil
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"flag"
+	"math"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/BurntSushi/toml"
+	"github.com/vmihailenco/msgpack/v5"
+	yamlv2 "gopkg.in/yaml.v2"
+	yamlv3 "gopkg.in/yaml.v3"
+)
+
+var durationRoundTripCases = []Duration{
+	ZeroDuration,
+	NewDuration(30 * time.Second),
+	NewDuration(90 * time.Minute),
+}
+
+func TestDurationJSONRoundTrip(t *testing.T) {
+	for _, d := range durationRoundTripCases {
+		data, err := json.Marshal(&d)
+		if err != nil {
+			t.Fatalf("Marshal(%s): %v", d, err)
+		}
+		var got Duration
+		if err := json.Unmarshal(data, &got); err != nil {
+			t.Fatalf("Unmarshal(%s): %v", data, err)
+		}
+		if got.Duration != d.Duration {
+			t.Errorf("round trip mismatch: got %s, want %s", got, d)
+		}
+	}
+}
+
+func TestDurationZeroValueMarshalsAsZeroSeconds(t *testing.T) {
+	jsonData, err := json.Marshal(&ZeroDuration)
+	if err != nil {
+		t.Fatalf("Marshal: %v", err)
+	}
+	if string(jsonData) != `"0s"` {
+		t.Errorf("JSON zero value = %s, want \"0s\"", jsonData)
+	}
+
+	yamlOut, err := ZeroDuration.MarshalYAML()
+	if err != nil {
+		t.Fatalf("MarshalYAML: %v", err)
+	}
+	if yamlOut != "0s" {
+		t.Errorf("YAML zero value = %v, want 0s", yamlOut)
+	}
+}
+
+func TestDurationTextRoundTrip(t *testing.T) {
+	for _, d := range durationRoundTripCases {
+		var got Duration
+		if err := got.UnmarshalText([]byte(d.String())); err != nil {
+			t.Fatalf("UnmarshalText(%s): %v", d, err)
+		}
+		if got.Duration != d.Duration {
+			t.Errorf("round trip mismatch: got %s, want %s", got, d)
+		}
+	}
+}
+
+func TestDurationYAMLRoundTrip(t *testing.T) {
+	marshalers := map[string]func(interface{}) ([]byte, error){
+		"yaml.v2": yamlv2.Marshal,
+		"yaml.v3": yamlv3.Marshal,
+	}
+	unmarshalers := map[string]func([]byte, interface{}) error{
+		"yaml.v2": yamlv2.Unmarshal,
+		"yaml.v3": yamlv3.Unmarshal,
+	}
+
+	for name, marshal := range marshalers {
+		unmarshal := unmarshalers[name]
+		for _, d := range durationRoundTripCases {
+			data, err := marshal(&d)
+			if err != nil {
+				t.Fatalf("%s Marshal(%s): %v", name, d, err)
+			}
+			var got Duration
+			if err := unmarshal(data, &got); err != nil {
+				t.Fatalf("%s Unmarshal(%s): %v", name, data, err)
+			}
+			if got.Duration != d.Duration {
+				t.Errorf("%s round trip mismatch: got %s, want %s", name, got, d)
+			}
+		}
+	}
+}
+
+func TestParseExtendedDuration(t *testing.T) {
+	cases := []struct {
+		in   string
+		want time.Duration
+	}{
+		{"10", 10 * time.Second},
+		{"1.5", 1500 * time.Millisecond},
+		{"2d", 48 * time.Hour},
+		{"1w", 7 * 24 * time.Hour},
+		{"1y", 365 * 24 * time.Hour},
+		{"1w12h30m", 7*24*time.Hour + 12*time.Hour + 30*time.Minute},
+		{"90m", 90 * time.Minute},
+	}
+	for _, c := range cases {
+		got, err := ParseExtendedDuration(c.in)
+		if err != nil {
+			t.Fatalf("ParseExtendedDuration(%q): %v", c.in, err)
+		}
+		if got != c.want {
+			t.Errorf("ParseExtendedDuration(%q) = %s, want %s", c.in, got, c.want)
+		}
+	}
+}
+
+func TestParseExtendedDurationRejectsOverflow(t *testing.T) {
+	cases := []string{"1e30", "-1e30", "999999999999999999999"}
+	for _, in := range cases {
+		if _, err := ParseExtendedDuration(in); err == nil {
+			t.Errorf("ParseExtendedDuration(%q): expected an error, got nil", in)
+		} else if !strings.Contains(err.Error(), "duration too large") {
+			t.Errorf("ParseExtendedDuration(%q) error = %q, want it to say duration too large", in, err)
+		}
+	}
+}
+
+func TestDurationSet(t *testing.T) {
+	var d Duration
+	if err := d.Set("2d"); err != nil {
+		t.Fatalf("Set: %v", err)
+	}
+	if d.Duration != 48*time.Hour {
+		t.Errorf("Set(\"2d\") = %s, want 48h", d)
+	}
+
+	var flagValue flag.Value = &d
+	if err := flagValue.Set("90m"); err != nil {
+		t.Fatalf("Set via flag.Value: %v", err)
+	}
+	if d.Duration != 90*time.Minute {
+		t.Errorf("Set(\"90m\") = %s, want 90m", d)
+	}
+}
+
+func TestDurationUnmarshalJSONEmptyAndNull(t *testing.T) {
+	cases := []string{`""`, "null"}
+	for _, in := range cases {
+		d := NewDuration(time.Hour)
+		if err := d.UnmarshalJSON([]byte(in)); err != nil {
+			t.Fatalf("UnmarshalJSON(%s): %v", in, err)
+		}
+		if d.Duration != 0 {
+			t.Errorf("UnmarshalJSON(%s) = %s, want 0s", in, d)
+		}
+	}
+
+	var d Duration
+	if err := d.UnmarshalJSON([]byte(`"garbage"`)); err == nil {
+		t.Fatal(`UnmarshalJSON("garbage"): expected an error, got nil`)
+	}
+}
+
+func TestDurationMsgpackRoundTrip(t *testing.T) {
+	type config struct {
+		Timeout  Duration
+		Cooldown Duration
+	}
+	c := config{Timeout: NewDuration(5 * time.Second), Cooldown: NewDuration(-90 * time.Second)}
+
+	data, err := msgpack.Marshal(&c)
+	if err != nil {
+		t.Fatalf("Marshal: %v", err)
+	}
+
+	var got config
+	if err := msgpack.Unmarshal(data, &got); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+	if got.Timeout.Duration != c.Timeout.Duration {
+		t.Errorf("Timeout round trip mismatch: got %s, want %s", got.Timeout, c.Timeout)
+	}
+	if got.Cooldown.Duration != c.Cooldown.Duration {
+		t.Errorf("Cooldown round trip mismatch: got %s, want %s", got.Cooldown, c.Cooldown)
+	}
+}
+
+func TestDurationGobRoundTrip(t *testing.T) {
+	type config struct {
+		Timeout Duration
+	}
+	c := config{Timeout: NewDuration(-90 * time.Second)}
+
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(c); err != nil {
+		t.Fatalf("Encode: %v", err)
+	}
+
+	var got config
+	if err := gob.NewDecoder(&buf).Decode(&got); err != nil {
+		t.Fatalf("Decode: %v", err)
+	}
+	if got.Timeout.Duration != c.Timeout.Duration {
+		t.Errorf("round trip mismatch: got %s, want %s", got.Timeout, c.Timeout)
+	}
+}
+
+func TestDurationGobEncodeMapRoundTrip(t *testing.T) {
+	want := map[string]Duration{
+		"zero": ZeroDuration,
+		"neg":  NewDuration(-90 * time.Second),
+		"pos":  NewDuration(3 * time.Hour),
+	}
+
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(want); err != nil {
+		t.Fatalf("Encode: %v", err)
+	}
+
+	var got map[string]Duration
+	if err := gob.NewDecoder(&buf).Decode(&got); err != nil {
+		t.Fatalf("Decode: %v", err)
+	}
+	for key, d := range want {
+		if got[key].Duration != d.Duration {
+			t.Errorf("key %q: got %s, want %s", key, got[key], d)
+		}
+	}
+}
+
+func TestDurationUnmarshalBinaryShortBuffer(t *testing.T) {
+	var d Duration
+	if err := d.UnmarshalBinary([]byte{1, 2, 3}); err == nil {
+		t.Fatal("UnmarshalBinary: expected an error for a short buffer, got nil")
+	}
+}
+
+func TestDurationHumanString(t *testing.T) {
+	cases := []struct {
+		in   time.Duration
+		want string
+	}{
+		{0, "0s"},
+		{500 * time.Microsecond, "500µs"},
+		{500 * time.Millisecond, "500ms"},
+		{1 * time.Second, "1 second"},
+		{45 * time.Second, "45 seconds"},
+		{90 * time.Second, "1 minute 30 seconds"},
+		{5 * time.Minute, "5 minutes"},
+		{90 * time.Minute, "1 hour 30 minutes"},
+		{2 * time.Hour, "2 hours"},
+		{25 * time.Hour, "1 day 1 hour"},
+		{72*time.Hour + 3*time.Minute, "3 days"},
+		{-90 * time.Second, "-1 minute 30 seconds"},
+	}
+	for _, c := range cases {
+		if got := NewDuration(c.in).HumanString(); got != c.want {
+			t.Errorf("HumanString(%s) = %q, want %q", c.in, got, c.want)
+		}
+	}
+}
+
+func TestParseDurationOr(t *testing.T) {
+	cases := []struct {
+		name string
+		in   string
+		def  time.Duration
+		want time.Duration
+	}{
+		{"empty falls back", "", time.Second, time.Second},
+		{"valid parses", "5s", time.Second, 5 * time.Second},
+		{"invalid falls back", "not-a-duration", time.Second, time.Second},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if got := ParseDurationOr(c.in, c.def); got.Duration != c.want {
+				t.Errorf("ParseDurationOr(%q, %s) = %s, want %s", c.in, c.def, got, c.want)
+			}
+		})
+	}
+}
+
+func TestMustParseDuration(t *testing.T) {
+	if got := MustParseDuration("90m"); got.Duration != 90*time.Minute {
+		t.Errorf(`MustParseDuration("90m") = %s, want 90m`, got)
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("MustParseDuration(invalid): expected a panic, got none")
+		}
+	}()
+	MustParseDuration("not-a-duration")
+}
+
+func TestParseDurationLenient(t *testing.T) {
+	cases := []struct {
+		in   string
+		want time.Duration
+	}{
+		{"1d", 24 * time.Hour},
+		{"2w", 14 * 24 * time.Hour},
+		{"45", 45 * time.Second},
+	}
+	for _, c := range cases {
+		got, err := ParseDurationLenient(c.in)
+		if err != nil {
+			t.Fatalf("ParseDurationLenient(%q): %v", c.in, err)
+		}
+		if got.Duration != c.want {
+			t.Errorf("ParseDurationLenient(%q) = %s, want %s", c.in, got, c.want)
+		}
+	}
+}
+
+func TestDurationUnmarshalJSONRejectsExtendedUnits(t *testing.T) {
+	var d Duration
+	if err := d.UnmarshalJSON([]byte(`"1d"`)); err == nil {
+		t.Fatal(`UnmarshalJSON("1d"): expected an error on the strict path, got nil`)
+	}
+}
+
+func TestDurationArithmetic(t *testing.T) {
+	a := NewDuration(30 * time.Second)
+	b := NewDuration(time.Minute)
+
+	if got := a.Add(b.Duration); got.Duration != 90*time.Second {
+		t.Errorf("Add = %s, want 90s", got)
+	}
+	if got := b.Sub(a.Duration); got.Duration != 30*time.Second {
+		t.Errorf("Sub = %s, want 30s", got)
+	}
+	if !a.Less(b) {
+		t.Errorf("Less(%s, %s) = false, want true", a, b)
+	}
+	if a.Equal(b) {
+		t.Errorf("Equal(%s, %s) = true, want false", a, b)
+	}
+	if !a.Equal(NewDuration(30 * time.Second)) {
+		t.Errorf("Equal(%s, 30s) = false, want true", a)
+	}
+}
+
+func TestDurationMulSaturates(t *testing.T) {
+	cases := []struct {
+		name   string
+		d      time.Duration
+		factor int64
+		want   time.Duration
+	}{
+		{"normal", time.Second, 2, 2 * time.Second},
+		{"overflow positive", time.Hour, math.MaxInt64, math.MaxInt64},
+		{"overflow negative", time.Hour, math.MinInt64, math.MinInt64},
+		{"negative factor", time.Second, -3, -3 * time.Second},
+		{"zero", time.Second, 0, 0},
+		{"min int64 times minus one", math.MinInt64, -1, math.MaxInt64},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			got := NewDuration(c.d).Mul(c.factor)
+			if got.Duration != c.want {
+				t.Errorf("Mul(%s, %d) = %s, want %s", c.d, c.factor, got, c.want)
+			}
+		})
+	}
+}
+
+func TestDurationIntSecondsAndMillis(t *testing.T) {
+	cases := []struct {
+		in          time.Duration
+		wantSeconds int64
+		wantMillis  int64
+	}{
+		{1500 * time.Millisecond, 1, 1500},
+		{-1500 * time.Millisecond, -1, -1500},
+		{999 * time.Millisecond, 0, 999},
+	}
+	for _, c := range cases {
+		d := NewDuration(c.in)
+		if got := d.IntSeconds(); got != c.wantSeconds {
+			t.Errorf("IntSeconds(%s) = %d, want %d", c.in, got, c.wantSeconds)
+		}
+		if got := d.IntMillis(); got != c.wantMillis {
+			t.Errorf("IntMillis(%s) = %d, want %d", c.in, got, c.wantMillis)
+		}
+	}
+}
+
+func TestDurationSignPredicates(t *testing.T) {
+	cases := []struct {
+		name                       string
+		d                          time.Duration
+		wantZero, wantPos, wantNeg bool
+	}{
+		{"zero", 0, true, false, false},
+		{"smallest positive", time.Nanosecond, false, true, false},
+		{"smallest negative", -time.Nanosecond, false, false, true},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			d := NewDuration(c.d)
+			if got := d.IsZero(); got != c.wantZero {
+				t.Errorf("IsZero() = %v, want %v", got, c.wantZero)
+			}
+			if got := d.IsPositive(); got != c.wantPos {
+				t.Errorf("IsPositive() = %v, want %v", got, c.wantPos)
+			}
+			if got := d.IsNegative(); got != c.wantNeg {
+				t.Errorf("IsNegative() = %v, want %v", got, c.wantNeg)
+			}
+		})
+	}
+}
+
+func TestDurationHashEqualForEqualDurations(t *testing.T) {
+	a := NewDuration(90 * time.Second)
+	b := NewDuration(90 * time.Second)
+	if a.Hash() != b.Hash() {
+		t.Errorf("Hash() mismatch for equal durations: %d != %d", a.Hash(), b.Hash())
+	}
+}
+
+func TestDurationHashDiffersForDifferentDurations(t *testing.T) {
+	a := NewDuration(90 * time.Second)
+	b := NewDuration(91 * time.Second)
+	if a.Hash() == b.Hash() {
+		t.Errorf("Hash() collided for different durations: both %d", a.Hash())
+	}
+}
+
+func TestEqualDurationSlices(t *testing.T) {
+	a := []Duration{NewDuration(time.Second), NewDuration(time.Minute)}
+	b := []Duration{NewDuration(time.Second), NewDuration(time.Minute)}
+	c := []Duration{NewDuration(time.Minute), NewDuration(time.Second)}
+
+	if !EqualDurationSlices(a, b) {
+		t.Error("EqualDurationSlices(a, b) = false, want true")
+	}
+	if EqualDurationSlices(a, c) {
+		t.Error("EqualDurationSlices(a, c) = true, want false (different order)")
+	}
+	if EqualDurationSlices(a, a[:1]) {
+		t.Error("EqualDurationSlices with mismatched lengths = true, want false")
+	}
+}
+
+func TestDurationAbs(t *testing.T) {
+	if got := NewDuration(-5 * time.Second).Abs(); got.Duration != 5*time.Second {
+		t.Errorf("Abs(-5s) = %s, want 5s", got)
+	}
+	if got := NewDuration(5 * time.Second).Abs(); got.Duration != 5*time.Second {
+		t.Errorf("Abs(5s) = %s, want 5s", got)
+	}
+}
+
+func TestDurationAbsMinInt64(t *testing.T) {
+	d := NewDuration(math.MinInt64)
+	got := d.Abs()
+	if got.Duration != math.MaxInt64 {
+		t.Errorf("Abs(MinInt64) = %d, want MaxInt64", got.Duration)
+	}
+}
+
+func TestDurationRoundAndTruncate(t *testing.T) {
+	d := NewDuration(1500 * time.Millisecond)
+
+	rounded := d.Round(time.Second)
+	var _ Duration = rounded // return type must be the package type
+	if rounded.Duration != 2*time.Second {
+		t.Errorf("Round(1s) = %s, want 2s", rounded)
+	}
+
+	truncated := d.Truncate(time.Second)
+	var _ Duration = truncated
+	if truncated.Duration != time.Second {
+		t.Errorf("Truncate(1s) = %s, want 1s", truncated)
+	}
+}
+
+func TestDurationClamp(t *testing.T) {
+	cases := []struct {
+		name     string
+		d        time.Duration
+		min, max time.Duration
+		want     time.Duration
+	}{
+		{"within range", 30 * time.Second, time.Second, time.Minute, 30 * time.Second},
+		{"below minimum", -5 * time.Second, time.Second, time.Minute, time.Second},
+		{"above maximum", 2 * time.Minute, time.Second, time.Minute, time.Minute},
+		{"inverted bounds prefer min", 30 * time.Second, time.Minute, time.Second, time.Minute},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			got := NewDuration(c.d).Clamp(c.min, c.max)
+			if got.Duration != c.want {
+				t.Errorf("Clamp(%s, %s, %s) = %s, want %s", c.d, c.min, c.max, got, c.want)
+			}
+		})
+	}
+}
+
+func TestDurationValidate(t *testing.T) {
+	cases := []struct {
+		name     string
+		d        time.Duration
+		min, max time.Duration
+		wantErr  bool
+	}{
+		{"within range", 30 * time.Second, time.Second, time.Minute, false},
+		{"below minimum", -5 * time.Second, time.Second, time.Minute, true},
+		{"above maximum", 2 * time.Minute, time.Second, time.Minute, true},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			err := NewDuration(c.d).Validate(c.min, c.max)
+			if (err != nil) != c.wantErr {
+				t.Errorf("Validate(%s, %s, %s) error = %v, wantErr %v", c.d, c.min, c.max, err, c.wantErr)
+			}
+		})
+	}
+}
+
+func TestDurationFlagSetVar(t *testing.T) {
+	var d Duration
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	fs.Var(&d, "timeout", "request timeout")
+
+	if err := fs.Parse([]string{"-timeout=1w12h30m"}); err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+	want := 7*24*time.Hour + 12*time.Hour + 30*time.Minute
+	if d.Duration != want {
+		t.Errorf("-timeout=1w12h30m = %s, want %s", d, want)
+	}
+}
+
+func TestDurationUnmarshalJSONBareNumberIsNanoseconds(t *testing.T) {
+	var d Duration
+	if err := d.UnmarshalJSON([]byte("1500000000")); err != nil {
+		t.Fatalf("UnmarshalJSON: %v", err)
+	}
+	if d.Duration != 1500*time.Millisecond {
+		t.Errorf("UnmarshalJSON(1500000000) = %s, want 1.5s", d)
+	}
+}
+
+func TestDurationValueRoundTrip(t *testing.T) {
+	d := NewDuration(2 * time.Hour)
+	v, err := d.Value()
+	if err != nil {
+		t.Fatalf("Value: %v", err)
+	}
+
+	var got Duration
+	if err := got.Scan(v); err != nil {
+		t.Fatalf("Scan(%v): %v", v, err)
+	}
+	if got.Duration != d.Duration {
+		t.Errorf("round trip mismatch: got %s, want %s", got, d)
+	}
+}
+
+func TestDurationScanFromBytes(t *testing.T) {
+	var d Duration
+	if err := d.Scan([]byte("2h")); err != nil {
+		t.Fatalf("Scan: %v", err)
+	}
+	if d.Duration != 2*time.Hour {
+		t.Errorf("Scan([]byte(\"2h\")) = %s, want 2h", d)
+	}
+}
+
+func TestDurationScanNil(t *testing.T) {
+	d := NewDuration(time.Hour)
+	if err := d.Scan(nil); err != nil {
+		t.Fatalf("Scan(nil): %v", err)
+	}
+	if d.Duration != 0 {
+		t.Errorf("Scan(nil) = %s, want 0s", d)
+	}
+}
+
+func TestDurationScanSourceTypes(t *testing.T) {
+	cases := []struct {
+		name string
+		src  interface{}
+		want time.Duration
+	}{
+		{"int64", int64(2 * time.Hour), 2 * time.Hour},
+		{"time.Duration", 2 * time.Hour, 2 * time.Hour},
+		{"int", int(5), 5 * time.Nanosecond},
+		{"int32", int32(5), 5 * time.Nanosecond},
+		{"float64 seconds", float64(1.5), 1500 * time.Millisecond},
+		{"string", "2h", 2 * time.Hour},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			var d Duration
+			if err := d.Scan(c.src); err != nil {
+				t.Fatalf("Scan(%v): %v", c.src, err)
+			}
+			if d.Duration != c.want {
+				t.Errorf("Scan(%v) = %s, want %s", c.src, d, c.want)
+			}
+		})
+	}
+}
+
+func TestDurationScanRejectsUnsupportedType(t *testing.T) {
+	var d Duration
+	err := d.Scan(true)
+	if err == nil {
+		t.Fatal("Scan(true): expected an error, got nil")
+	}
+	if !strings.Contains(err.Error(), "cannot scan bool into Duration") {
+		t.Errorf("Scan(true) error = %q, want it to name the type", err)
+	}
+}
+
+func TestDurationASCIIString(t *testing.T) {
+	cases := []struct {
+		d    Duration
+		want string
+	}{
+		{NewDuration(500 * time.Microsecond), "500us"},
+		{NewDuration(2 * time.Second), "2s"},
+		{NewDuration(-500 * time.Microsecond), "-500us"},
+	}
+	for _, c := range cases {
+		if got := c.d.ASCIIString(); got != c.want {
+			t.Errorf("ASCIIString(%s) = %q, want %q", c.d, got, c.want)
+		}
+	}
+}
+
+func TestDurationYAMLNullLeavesZero(t *testing.T) {
+	d := NewDuration(time.Hour)
+	if err := yamlv2.Unmarshal([]byte("null"), &d); err != nil {
+		t.Fatalf("yaml.v2 Unmarshal: %v", err)
+	}
+	if d.Duration != 0 {
+		t.Errorf("yaml.v2 null = %s, want 0s", d)
+	}
+}
+
+// TestDurationYAMLv3NullLeavesValueUntouched documents a yaml.v3 quirk:
+// unlike yaml.v2, it never invokes the legacy UnmarshalYAML interface for
+// a null scalar, so the destination keeps whatever value it already held.
+func TestDurationYAMLv3NullLeavesValueUntouched(t *testing.T) {
+	d := NewDuration(time.Hour)
+	if err := yamlv3.Unmarshal([]byte("null"), &d); err != nil {
+		t.Fatalf("yaml.v3 Unmarshal: %v", err)
+	}
+	if d.Duration != time.Hour {
+		t.Errorf("yaml.v3 null = %s, want unchanged 1h0m0s", d)
+	}
+}
+
+func TestDurationYAMLRejectsNonStringScalar(t *testing.T) {
+	var d Duration
+	err := yamlv2.Unmarshal([]byte("true"), &d)
+	if err == nil {
+		t.Fatal("Unmarshal(true): expected an error, got nil")
+	}
+	if !strings.Contains(err.Error(), "true") {
+		t.Errorf("error %q does not name the offending value", err)
+	}
+}
+
+func TestDurationMarshalJSONByValue(t *testing.T) {
+	v := struct {
+		D Duration
+	}{D: NewDuration(time.Second)}
+
+	data, err := json.Marshal(v)
+	if err != nil {
+		t.Fatalf("Marshal: %v", err)
+	}
+	if string(data) != `{"D":"1s"}` {
+		t.Errorf("Marshal(value) = %s, want {\"D\":\"1s\"}", data)
+	}
+}
+
+func TestDurationCompactString(t *testing.T) {
+	cases := []struct {
+		in   time.Duration
+		want string
+	}{
+		{0, "0s"},
+		{time.Minute, "1m"},
+		{time.Hour, "1h"},
+		{90 * time.Second, "1m30s"},
+		{90 * time.Minute, "1h30m"},
+		{500 * time.Millisecond, "500ms"},
+		{-time.Hour, "-1h"},
+		{time.Hour + 5*time.Second, "1h0m5s"},
+	}
+	for _, c := range cases {
+		d := NewDuration(c.in)
+		if got := d.CompactString(); got != c.want {
+			t.Errorf("CompactString(%s) = %q, want %q", c.in, got, c.want)
+		}
+		reparsed, err := time.ParseDuration(d.CompactString())
+		if err != nil {
+			t.Fatalf("ParseDuration(%s): %v", d.CompactString(), err)
+		}
+		if reparsed != c.in {
+			t.Errorf("CompactString(%s) does not re-parse to the same value: got %s", c.in, reparsed)
+		}
+	}
+}
+
+func TestDurationAppendJSON(t *testing.T) {
+	d := NewDuration(90 * time.Second)
+
+	dst := []byte("prefix:")
+	got := d.AppendJSON(dst)
+	if string(got) != `prefix:"1m30s"` {
+		t.Errorf("AppendJSON = %s, want prefix:\"1m30s\"", got)
+	}
+
+	data, err := d.MarshalJSON()
+	if err != nil {
+		t.Fatalf("MarshalJSON: %v", err)
+	}
+	if string(data) != `"1m30s"` {
+		t.Errorf("MarshalJSON = %s, want \"1m30s\"", data)
+	}
+}
+
+func BenchmarkMarshalJSON(b *testing.B) {
+	d := NewDuration(90 * time.Second)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if _, err := d.MarshalJSON(); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkDurationAppendJSON(b *testing.B) {
+	d := NewDuration(90 * time.Second)
+	dst := make([]byte, 0, 16)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		dst = d.AppendJSON(dst[:0])
+	}
+}
+
+func TestDurationTOMLRoundTrip(t *testing.T) {
+	type config struct {
+		Timeout Duration `toml:"timeout"`
+	}
+	c := config{Timeout: NewDuration(90 * time.Minute)}
+
+	var buf bytes.Buffer
+	if err := toml.NewEncoder(&buf).Encode(c); err != nil {
+		t.Fatalf("Encode: %v", err)
+	}
+
+	var got config
+	if err := toml.Unmarshal(buf.Bytes(), &got); err != nil {
+		t.Fatalf("Unmarshal(%s): %v", buf.String(), err)
+	}
+	if got.Timeout.Duration != c.Timeout.Duration {
+		t.Errorf("round trip mismatch: got %s, want %s", got.Timeout, c.Timeout)
+	}
+}
+
+func TestDurationUnmarshalJSONMatrix(t *testing.T) {
+	cases := []struct {
+		in   string
+		want time.Duration
+	}{
+		{`"1h30m"`, 90 * time.Minute},
+		{"0", 0},
+		{"5000000000", 5 * time.Second},
+		{"-5000000000", -5 * time.Second},
+	}
+	for _, c := range cases {
+		var d Duration
+		if err := d.UnmarshalJSON([]byte(c.in)); err != nil {
+			t.Fatalf("UnmarshalJSON(%s): %v", c.in, err)
+		}
+		if d.Duration != c.want {
+			t.Errorf("UnmarshalJSON(%s) = %s, want %s", c.in, d, c.want)
+		}
+	}
+}
+
+func TestDurationYAMLBareIntegerIsNanoseconds(t *testing.T) {
+	unmarshalers := map[string]func([]byte, interface{}) error{
+		"yaml.v2": yamlv2.Unmarshal,
+		"yaml.v3": yamlv3.Unmarshal,
+	}
+	for name, unmarshal := range unmarshalers {
+		var got Duration
+		if err := unmarshal([]byte("30"), &got); err != nil {
+			t.Fatalf("%s Unmarshal: %v", name, err)
+		}
+		if got.Duration != 30*time.Nanosecond {
+			t.Errorf("%s bare integer = %s, want 30ns", name, got)
+		}
+	}
+}
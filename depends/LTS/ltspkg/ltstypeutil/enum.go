@@ -0,0 +1,87 @@
+package ltstypeutil
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/juju/errors"
+)
+
+// EnumDef is an immutable, case-insensitive set of allowed string values
+// for config knobs like `"debug"|"info"|"warn"`.
+type EnumDef struct {
+	canonical []string
+	index     map[string]string // lowercased spelling -> canonical spelling
+}
+
+// NewEnum builds an EnumDef from allowed, which are stored as the
+// canonical spelling reported by MarshalJSON and error messages.
+func NewEnum(allowed ...string) EnumDef {
+	index := make(map[string]string, len(allowed))
+	for _, a := range allowed {
+		index[strings.ToLower(a)] = a
+	}
+	return EnumDef{canonical: allowed, index: index}
+}
+
+// Parse matches s against def case-insensitively, returning its canonical
+// spelling.
+func (def EnumDef) Parse(s string) (string, error) {
+	if canonical, ok := def.index[strings.ToLower(s)]; ok {
+		return canonical, nil
+	}
+	return "", errors.Errorf("invalid value %q; must be one of %v", s, def.canonical)
+}
+
+// Value returns an Enum bound to def holding s's canonical spelling, or an
+// error if s is not one of the allowed values.
+func (def EnumDef) Value(s string) (Enum, error) {
+	canonical, err := def.Parse(s)
+	if err != nil {
+		return Enum{}, errors.Trace(err)
+	}
+	return Enum{def: &def, value: canonical}, nil
+}
+
+// Enum is a single value produced by an EnumDef. Its zero value is not
+// usable directly; construct one with EnumDef.Value.
+type Enum struct {
+	def   *EnumDef
+	value string
+}
+
+// String returns the canonical spelling.
+func (e Enum) String() string {
+	return e.value
+}
+
+// MarshalJSON returns the canonical spelling as a JSON string.
+func (e Enum) MarshalJSON() ([]byte, error) {
+	return []byte(strconv.Quote(e.value)), nil
+}
+
+// UnmarshalJSON matches a JSON string against e's EnumDef case
+// insensitively. e must already be bound to an EnumDef (typically by
+// initializing the field with EnumDef.Value before unmarshaling into it),
+// since a definition cannot be recovered from JSON alone.
+func (e *Enum) UnmarshalJSON(text []byte) error {
+	s, err := strconv.Unquote(string(text))
+	if err != nil {
+		return errors.Trace(err)
+	}
+	return e.UnmarshalText([]byte(s))
+}
+
+// UnmarshalText matches s against e's EnumDef case insensitively. See
+// UnmarshalJSON for the binding requirement.
+func (e *Enum) UnmarshalText(text []byte) error {
+	if e.def == nil {
+		return errors.New("ltstypeutil: Enum has no EnumDef bound; construct it with EnumDef.Value first")
+	}
+	canonical, err := e.def.Parse(string(text))
+	if err != nil {
+		return errors.Trace(err)
+	}
+	e.value = canonical
+	return nil
+}
@@ -0,0 +1,66 @@
+package ltstypeutil
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+var logLevelDef = NewEnum("debug", "info", "warn")
+
+func TestEnumValueAndString(t *testing.T) {
+	v, err := logLevelDef.Value("INFO")
+	if err != nil {
+		t.Fatalf("Value(INFO): %v", err)
+	}
+	if v.String() != "info" {
+		t.Errorf("String() = %q, want info", v.String())
+	}
+}
+
+func TestEnumValueUnknown(t *testing.T) {
+	_, err := logLevelDef.Value("trace")
+	if err == nil {
+		t.Fatal("Value(trace): expected an error, got nil")
+	}
+	want := `invalid value "trace"; must be one of [debug info warn]`
+	if err.Error() != want {
+		t.Errorf("error = %q, want %q", err.Error(), want)
+	}
+}
+
+func TestEnumValueEmptyString(t *testing.T) {
+	if _, err := logLevelDef.Value(""); err == nil {
+		t.Fatal(`Value(""): expected an error, got nil`)
+	}
+}
+
+func TestEnumJSONRoundTrip(t *testing.T) {
+	v, err := logLevelDef.Value("debug")
+	if err != nil {
+		t.Fatalf("Value: %v", err)
+	}
+	data, err := json.Marshal(&v)
+	if err != nil {
+		t.Fatalf("Marshal: %v", err)
+	}
+	if string(data) != `"debug"` {
+		t.Errorf("Marshal = %s, want \"debug\"", data)
+	}
+
+	if err := v.UnmarshalJSON([]byte(`"WARN"`)); err != nil {
+		t.Fatalf("UnmarshalJSON(WARN): %v", err)
+	}
+	if v.String() != "warn" {
+		t.Errorf("after UnmarshalJSON(WARN), String() = %q, want warn", v.String())
+	}
+}
+
+func TestEnumUnmarshalJSONUnbound(t *testing.T) {
+	var v Enum
+	if err := v.UnmarshalJSON([]byte(`"debug"`)); err == nil {
+		t.Fatal("UnmarshalJSON on an unbound Enum: expected an error, got nil")
+	} else if !strings.Contains(err.Error(), "EnumDef") {
+		t.Errorf("error %q does not mention the missing EnumDef", err)
+	}
+}
@@ -0,0 +1,25 @@
+package ltstypeutil
+
+import (
+	"os"
+	"time"
+
+	"github.com/juju/errors"
+)
+
+// LoadDurationEnv reads key from the environment and parses it with
+// ParseExtendedDuration, returning def when the variable is unset or
+// empty. Parse failures are annotated with key so misconfiguration is easy
+// to trace back to its source.
+func LoadDurationEnv(key string, def time.Duration) (Duration, error) {
+	raw, ok := os.LookupEnv(key)
+	if !ok || raw == "" {
+		return NewDuration(def), nil
+	}
+
+	duration, err := ParseExtendedDuration(raw)
+	if err != nil {
+		return Duration{}, errors.Annotatef(err, "%s", key)
+	}
+	return NewDuration(duration), nil
+}
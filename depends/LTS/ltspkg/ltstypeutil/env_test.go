@@ -0,0 +1,39 @@
+package ltstypeutil
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestLoadDurationEnvUnset(t *testing.T) {
+	d, err := LoadDurationEnv("LTSTYPEUTIL_TEST_UNSET_VAR", 30*time.Second)
+	if err != nil {
+		t.Fatalf("LoadDurationEnv: %v", err)
+	}
+	if d.Duration != 30*time.Second {
+		t.Errorf("got %s, want default 30s", d)
+	}
+}
+
+func TestLoadDurationEnvValid(t *testing.T) {
+	t.Setenv("LTSTYPEUTIL_TEST_TIMEOUT", "5s")
+	d, err := LoadDurationEnv("LTSTYPEUTIL_TEST_TIMEOUT", time.Minute)
+	if err != nil {
+		t.Fatalf("LoadDurationEnv: %v", err)
+	}
+	if d.Duration != 5*time.Second {
+		t.Errorf("got %s, want 5s", d)
+	}
+}
+
+func TestLoadDurationEnvInvalid(t *testing.T) {
+	t.Setenv("LTSTYPEUTIL_TEST_TIMEOUT", "xyz")
+	_, err := LoadDurationEnv("LTSTYPEUTIL_TEST_TIMEOUT", time.Minute)
+	if err == nil {
+		t.Fatal("LoadDurationEnv: expected an error, got nil")
+	}
+	if !strings.Contains(err.Error(), "LTSTYPEUTIL_TEST_TIMEOUT") {
+		t.Errorf("error %q does not name the env var", err)
+	}
+}
@@ -0,0 +1,46 @@
+package ltstypeutil
+
+import (
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/juju/errors"
+)
+
+// FloatSecondsDuration is an opt-in Duration variant for upstream
+// producers that emit fractional JSON numbers meaning seconds (e.g. 1.5
+// for 1.5s), rather than a quoted Go duration string or integer
+// nanoseconds like Duration accepts.
+type FloatSecondsDuration struct {
+	Duration
+}
+
+// NewFloatSecondsDuration wraps d.
+func NewFloatSecondsDuration(d time.Duration) FloatSecondsDuration {
+	return FloatSecondsDuration{Duration: NewDuration(d)}
+}
+
+// MarshalJSON returns the duration in its string form, e.g. "1.5s", for
+// clarity over re-emitting an ambiguous bare number.
+func (d FloatSecondsDuration) MarshalJSON() ([]byte, error) {
+	return d.Duration.MarshalJSON()
+}
+
+// UnmarshalJSON accepts a JSON number of seconds (rounded to the nearest
+// nanosecond) or a quoted Go duration string like "1500ms".
+func (d *FloatSecondsDuration) UnmarshalJSON(text []byte) error {
+	if len(text) == 0 {
+		return ErrEmptyDuration
+	}
+	if text[0] == '"' {
+		return errors.Trace(d.Duration.UnmarshalJSON(text))
+	}
+
+	seconds, err := strconv.ParseFloat(string(text), 64)
+	if err != nil {
+		return wrapDurationError(ErrInvalidDuration, err.Error())
+	}
+	d.Duration.Duration = time.Duration(math.Round(seconds * float64(time.Second)))
+	return nil
+}
@@ -0,0 +1,37 @@
+package ltstypeutil
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestFloatSecondsDurationUnmarshalJSONFloat(t *testing.T) {
+	var d FloatSecondsDuration
+	if err := json.Unmarshal([]byte("1.5"), &d); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+	if d.Duration.Duration != 1500*time.Millisecond {
+		t.Errorf("got %s, want 1.5s", d.Duration)
+	}
+}
+
+func TestFloatSecondsDurationUnmarshalJSONString(t *testing.T) {
+	var d FloatSecondsDuration
+	if err := json.Unmarshal([]byte(`"1500ms"`), &d); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+	if d.Duration.Duration != 1500*time.Millisecond {
+		t.Errorf("got %s, want 1.5s", d.Duration)
+	}
+}
+
+func TestFloatSecondsDurationUnmarshalJSONHighPrecision(t *testing.T) {
+	var d FloatSecondsDuration
+	if err := json.Unmarshal([]byte("0.0000001"), &d); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+	if d.Duration.Duration != 100*time.Nanosecond {
+		t.Errorf("got %s, want 100ns", d.Duration)
+	}
+}
@@ -0,0 +1,72 @@
+package ltstypeutil
+
+import (
+	"regexp"
+	"strconv"
+	"time"
+
+	"github.com/juju/errors"
+)
+
+// iso8601DurationPattern matches an ISO 8601 duration like "PT1H30M" or
+// "P1DT2H". Calendar years and months are deliberately excluded: they have
+// no fixed length in time.Duration terms, so ParseISO8601Duration rejects
+// any "Y" or calendar "M" component rather than approximate one.
+var iso8601DurationPattern = regexp.MustCompile(`^P(?:(\d+(?:\.\d+)?)Y)?(?:(\d+(?:\.\d+)?)M)?(?:(\d+(?:\.\d+)?)W)?(?:(\d+(?:\.\d+)?)D)?(?:T(?:(\d+(?:\.\d+)?)H)?(?:(\d+(?:\.\d+)?)M)?(?:(\d+(?:\.\d+)?)S)?)?$`)
+
+// ParseISO8601Duration parses an ISO 8601 duration string such as "PT1H30M"
+// or "P1DT2H" into a time.Duration. Weeks and days are converted using the
+// same fixed 24-hour day as ParseExtendedDuration. Year and calendar-month
+// components are rejected with an error, since they have no fixed length.
+func ParseISO8601Duration(s string) (time.Duration, error) {
+	m := iso8601DurationPattern.FindStringSubmatch(s)
+	if m == nil || s == "P" || s == "PT" {
+		return 0, errors.Errorf("ltstypeutil: invalid ISO 8601 duration %q", s)
+	}
+	if m[1] != "" {
+		return 0, errors.Errorf("ltstypeutil: ISO 8601 duration %q has a calendar year component, which has no fixed length", s)
+	}
+	if m[2] != "" {
+		return 0, errors.Errorf("ltstypeutil: ISO 8601 duration %q has a calendar month component, which has no fixed length", s)
+	}
+
+	weeks, err := iso8601Component(m[3])
+	if err != nil {
+		return 0, errors.Trace(err)
+	}
+	days, err := iso8601Component(m[4])
+	if err != nil {
+		return 0, errors.Trace(err)
+	}
+	hours, err := iso8601Component(m[5])
+	if err != nil {
+		return 0, errors.Trace(err)
+	}
+	minutes, err := iso8601Component(m[6])
+	if err != nil {
+		return 0, errors.Trace(err)
+	}
+	seconds, err := iso8601Component(m[7])
+	if err != nil {
+		return 0, errors.Trace(err)
+	}
+
+	total := weeks*hoursPerWeek + days*hoursPerDay + hours
+	duration := time.Duration(total * float64(time.Hour))
+	duration += time.Duration(minutes * float64(time.Minute))
+	duration += time.Duration(seconds * float64(time.Second))
+	return duration, nil
+}
+
+// iso8601Component parses a possibly-empty numeric regex capture group,
+// returning 0 for an empty string.
+func iso8601Component(s string) (float64, error) {
+	if s == "" {
+		return 0, nil
+	}
+	n, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return 0, errors.Trace(err)
+	}
+	return n, nil
+}
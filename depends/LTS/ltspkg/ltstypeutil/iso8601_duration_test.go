@@ -0,0 +1,45 @@
+package ltstypeutil
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseISO8601Duration(t *testing.T) {
+	cases := []struct {
+		in   string
+		want time.Duration
+	}{
+		{"PT1H30M", time.Hour + 30*time.Minute},
+		{"P1DT2H", 24*time.Hour + 2*time.Hour},
+		{"PT0.5S", 500 * time.Millisecond},
+		{"P1W", 7 * 24 * time.Hour},
+		{"PT45S", 45 * time.Second},
+	}
+	for _, c := range cases {
+		got, err := ParseISO8601Duration(c.in)
+		if err != nil {
+			t.Errorf("ParseISO8601Duration(%q): %v", c.in, err)
+			continue
+		}
+		if got != c.want {
+			t.Errorf("ParseISO8601Duration(%q) = %s, want %s", c.in, got, c.want)
+		}
+	}
+}
+
+func TestParseISO8601DurationRejectsCalendarComponents(t *testing.T) {
+	for _, in := range []string{"P1Y", "P1M", "P1Y2M3D"} {
+		if _, err := ParseISO8601Duration(in); err == nil {
+			t.Errorf("ParseISO8601Duration(%q): expected an error, got nil", in)
+		}
+	}
+}
+
+func TestParseISO8601DurationRejectsMalformed(t *testing.T) {
+	for _, in := range []string{"", "P", "PT", "1H30M", "PXH"} {
+		if _, err := ParseISO8601Duration(in); err == nil {
+			t.Errorf("ParseISO8601Duration(%q): expected an error, got nil", in)
+		}
+	}
+}
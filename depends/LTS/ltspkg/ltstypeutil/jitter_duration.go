@@ -0,0 +1,96 @@
+package ltstypeutil
+
+import (
+	"math/rand"
+	"strings"
+	"time"
+
+	"github.com/juju/errors"
+)
+
+// JitterDuration is a base interval plus a symmetric jitter bound, parsed
+// from config as `"30s±5s"` (or plain `"30s"` for zero jitter), for retry
+// and heartbeat loops that want randomized backoff.
+type JitterDuration struct {
+	Base   time.Duration
+	Jitter time.Duration
+
+	// Rand is the source Next draws from. It defaults to rand.Float64 when
+	// nil, and is overridable for deterministic tests.
+	Rand func() float64
+}
+
+// NewJitterDuration creates a JitterDuration from a base interval and a
+// symmetric jitter bound.
+func NewJitterDuration(base, jitter time.Duration) JitterDuration {
+	return JitterDuration{Base: base, Jitter: jitter}
+}
+
+// Next returns Base plus a uniformly random offset in [-Jitter, +Jitter],
+// clamped at zero so it never returns a negative duration.
+func (j JitterDuration) Next() time.Duration {
+	randFloat := j.Rand
+	if randFloat == nil {
+		randFloat = rand.Float64
+	}
+	offset := time.Duration((randFloat()*2 - 1) * float64(j.Jitter))
+	next := j.Base + offset
+	if next < 0 {
+		return 0
+	}
+	return next
+}
+
+// MarshalJSON returns the interval in "base±jitter" form (or plain "base"
+// when there is no jitter), as a JSON string.
+func (j JitterDuration) MarshalJSON() ([]byte, error) {
+	text, err := j.MarshalText()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return []byte(`"` + string(text) + `"`), nil
+}
+
+// UnmarshalJSON parses a JSON `"base±jitter"` or `"base"` string.
+func (j *JitterDuration) UnmarshalJSON(text []byte) error {
+	if len(text) < 2 || text[0] != '"' {
+		return errors.Errorf("ltstypeutil: invalid JitterDuration %s", text)
+	}
+	s, err := unquoteJSONString(text)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	return errors.Trace(j.UnmarshalText([]byte(s)))
+}
+
+// MarshalText returns the interval in "base±jitter" form (or plain "base"
+// when there is no jitter).
+func (j JitterDuration) MarshalText() ([]byte, error) {
+	if j.Jitter == 0 {
+		return []byte(j.Base.String()), nil
+	}
+	return []byte(j.Base.String() + "±" + j.Jitter.String()), nil
+}
+
+// UnmarshalText parses a `"base±jitter"` or plain `"base"` string.
+func (j *JitterDuration) UnmarshalText(text []byte) error {
+	s := string(text)
+	parts := strings.SplitN(s, "±", 2)
+
+	base, err := time.ParseDuration(parts[0])
+	if err != nil {
+		return errors.Annotatef(err, "ltstypeutil: invalid JitterDuration base %q", parts[0])
+	}
+
+	var jitter time.Duration
+	if len(parts) == 2 {
+		jitter, err = time.ParseDuration(parts[1])
+		if err != nil {
+			return errors.Annotatef(err, "ltstypeutil: invalid JitterDuration jitter %q", parts[1])
+		}
+	}
+
+	j.Base = base
+	j.Jitter = jitter
+	return nil
+}
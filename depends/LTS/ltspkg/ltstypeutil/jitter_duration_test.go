@@ -0,0 +1,63 @@
+package ltstypeutil
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestJitterDurationUnmarshalText(t *testing.T) {
+	var j JitterDuration
+	if err := j.UnmarshalText([]byte("30s±5s")); err != nil {
+		t.Fatalf("UnmarshalText: %v", err)
+	}
+	if j.Base != 30*time.Second || j.Jitter != 5*time.Second {
+		t.Errorf("got Base=%s Jitter=%s, want 30s/5s", j.Base, j.Jitter)
+	}
+}
+
+func TestJitterDurationUnmarshalTextNoJitter(t *testing.T) {
+	var j JitterDuration
+	if err := j.UnmarshalText([]byte("30s")); err != nil {
+		t.Fatalf("UnmarshalText: %v", err)
+	}
+	if j.Base != 30*time.Second || j.Jitter != 0 {
+		t.Errorf("got Base=%s Jitter=%s, want 30s/0s", j.Base, j.Jitter)
+	}
+}
+
+func TestJitterDurationJSONRoundTrip(t *testing.T) {
+	var j JitterDuration
+	if err := json.Unmarshal([]byte(`"30s±5s"`), &j); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+	data, err := json.Marshal(&j)
+	if err != nil {
+		t.Fatalf("Marshal: %v", err)
+	}
+	if string(data) != `"30s±5s"` {
+		t.Errorf("Marshal = %s, want \"30s±5s\"", data)
+	}
+}
+
+func TestJitterDurationNextDeterministic(t *testing.T) {
+	j := NewJitterDuration(30*time.Second, 5*time.Second)
+
+	j.Rand = func() float64 { return 1 }
+	if got := j.Next(); got != 35*time.Second {
+		t.Errorf("Next() with Rand=1 = %s, want 35s", got)
+	}
+
+	j.Rand = func() float64 { return 0 }
+	if got := j.Next(); got != 25*time.Second {
+		t.Errorf("Next() with Rand=0 = %s, want 25s", got)
+	}
+}
+
+func TestJitterDurationNextClampsAtZero(t *testing.T) {
+	j := NewJitterDuration(2*time.Second, 5*time.Second)
+	j.Rand = func() float64 { return 0 }
+	if got := j.Next(); got != 0 {
+		t.Errorf("Next() = %s, want 0s (clamped)", got)
+	}
+}
@@ -0,0 +1,12 @@
+package ltstypeutil
+
+import "strconv"
+
+// unquoteJSONString decodes a JSON string token into its Go string value,
+// honoring JSON escape sequences (e.g. the `\d` in a regexp pattern, or a
+// literal `"`). Callers that accept a JSON string and delegate to an
+// UnmarshalText-style parser must use this instead of slicing off the
+// surrounding quote bytes, which leaves escape sequences un-decoded.
+func unquoteJSONString(text []byte) (string, error) {
+	return strconv.Unquote(string(text))
+}
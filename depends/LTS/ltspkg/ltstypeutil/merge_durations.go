@@ -0,0 +1,74 @@
+package ltstypeutil
+
+import (
+	"reflect"
+
+	"github.com/juju/errors"
+)
+
+// MergeDurations overlays the non-zero Duration fields of src onto dst,
+// recursing into nested (and nested pointer) structs. dst and src must be
+// pointers to the same struct type; fields where src's Duration is zero are
+// left untouched on dst, and unexported fields are skipped.
+func MergeDurations(dst, src interface{}) error {
+	dv := reflect.ValueOf(dst)
+	sv := reflect.ValueOf(src)
+	if dv.Kind() != reflect.Ptr || dv.Elem().Kind() != reflect.Struct {
+		return errors.Errorf("ltstypeutil: MergeDurations dst must be a pointer to a struct, got %T", dst)
+	}
+	if sv.Kind() != reflect.Ptr || sv.Elem().Kind() != reflect.Struct {
+		return errors.Errorf("ltstypeutil: MergeDurations src must be a pointer to a struct, got %T", src)
+	}
+	if dv.Type() != sv.Type() {
+		return errors.Errorf("ltstypeutil: MergeDurations dst and src must be the same type, got %T and %T", dst, src)
+	}
+	return errors.Trace(mergeDurationsStruct(dv.Elem(), sv.Elem()))
+}
+
+// mergeDurationsStruct recurses through dst and src, overlaying any
+// non-zero Duration field of src onto the matching field of dst.
+func mergeDurationsStruct(dst, src reflect.Value) error {
+	t := dst.Type()
+	for i := 0; i < t.NumField(); i++ {
+		if !dst.Field(i).CanSet() {
+			continue
+		}
+		df, sf := dst.Field(i), src.Field(i)
+
+		switch d := df.Addr().Interface().(type) {
+		case *Duration:
+			if s := sf.Addr().Interface().(*Duration); !s.IsZero() {
+				*d = *s
+			}
+			continue
+		}
+
+		switch df.Kind() {
+		case reflect.Struct:
+			if err := mergeDurationsStruct(df, sf); err != nil {
+				return errors.Trace(err)
+			}
+		case reflect.Ptr:
+			if _, ok := df.Addr().Interface().(**Duration); ok {
+				sp := sf.Interface().(*Duration)
+				if sp != nil && !sp.IsZero() {
+					d := *sp
+					df.Set(reflect.ValueOf(&d))
+				}
+				continue
+			}
+			if sf.IsNil() {
+				continue
+			}
+			if df.IsNil() {
+				df.Set(reflect.New(df.Type().Elem()))
+			}
+			if df.Elem().Kind() == reflect.Struct {
+				if err := mergeDurationsStruct(df.Elem(), sf.Elem()); err != nil {
+					return errors.Trace(err)
+				}
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,84 @@
+package ltstypeutil
+
+import (
+	"testing"
+	"time"
+)
+
+type mergeDurationsInner struct {
+	Timeout Duration
+}
+
+type mergeDurationsOuter struct {
+	Retry      Duration
+	Inner      mergeDurationsInner
+	InnerPtr   *mergeDurationsInner
+	TimeoutPtr *Duration
+	unexported Duration
+}
+
+func TestMergeDurationsOverlaysNonZero(t *testing.T) {
+	dst := &mergeDurationsOuter{
+		Retry: NewDuration(time.Second),
+		Inner: mergeDurationsInner{Timeout: NewDuration(time.Minute)},
+	}
+	src := &mergeDurationsOuter{
+		Retry:    ZeroDuration,
+		Inner:    mergeDurationsInner{Timeout: NewDuration(5 * time.Minute)},
+		InnerPtr: &mergeDurationsInner{Timeout: NewDuration(10 * time.Second)},
+	}
+
+	if err := MergeDurations(dst, src); err != nil {
+		t.Fatalf("MergeDurations: %v", err)
+	}
+	if dst.Retry.Duration != time.Second {
+		t.Errorf("Retry = %s, want unchanged 1s (src was zero)", dst.Retry)
+	}
+	if dst.Inner.Timeout.Duration != 5*time.Minute {
+		t.Errorf("Inner.Timeout = %s, want overlaid 5m", dst.Inner.Timeout)
+	}
+	if dst.InnerPtr == nil || dst.InnerPtr.Timeout.Duration != 10*time.Second {
+		t.Errorf("InnerPtr = %+v, want allocated with Timeout 10s", dst.InnerPtr)
+	}
+}
+
+func TestMergeDurationsOverlaysDurationPointerField(t *testing.T) {
+	dst := &mergeDurationsOuter{TimeoutPtr: nil}
+	srcTimeout := NewDuration(5 * time.Second)
+	src := &mergeDurationsOuter{TimeoutPtr: &srcTimeout}
+
+	if err := MergeDurations(dst, src); err != nil {
+		t.Fatalf("MergeDurations: %v", err)
+	}
+	if dst.TimeoutPtr == nil || dst.TimeoutPtr.Duration != 5*time.Second {
+		t.Errorf("TimeoutPtr = %+v, want overlaid 5s", dst.TimeoutPtr)
+	}
+}
+
+func TestMergeDurationsSkipsZeroDurationPointerField(t *testing.T) {
+	existing := NewDuration(time.Second)
+	dst := &mergeDurationsOuter{TimeoutPtr: &existing}
+	zero := ZeroDuration
+	src := &mergeDurationsOuter{TimeoutPtr: &zero}
+
+	if err := MergeDurations(dst, src); err != nil {
+		t.Fatalf("MergeDurations: %v", err)
+	}
+	if dst.TimeoutPtr == nil || dst.TimeoutPtr.Duration != time.Second {
+		t.Errorf("TimeoutPtr = %+v, want unchanged 1s (src was zero)", dst.TimeoutPtr)
+	}
+}
+
+func TestMergeDurationsRejectsMismatchedTypes(t *testing.T) {
+	dst := &mergeDurationsOuter{}
+	var src struct{ Retry Duration }
+	if err := MergeDurations(dst, &src); err == nil {
+		t.Fatal("MergeDurations: expected an error for mismatched types, got nil")
+	}
+}
+
+func TestMergeDurationsRejectsNonPointer(t *testing.T) {
+	if err := MergeDurations(mergeDurationsOuter{}, &mergeDurationsOuter{}); err == nil {
+		t.Fatal("MergeDurations: expected an error for non-pointer dst, got nil")
+	}
+}
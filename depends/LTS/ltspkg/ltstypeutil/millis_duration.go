@@ -0,0 +1,52 @@
+package ltstypeutil
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/juju/errors"
+)
+
+// MillisDuration is a wrapper of time.Duration for front ends that expect
+// timeouts as an integer count of milliseconds rather than a Go duration
+// string.
+type MillisDuration struct {
+	time.Duration
+}
+
+// NewMillisDuration creates a MillisDuration from time.Duration.
+func NewMillisDuration(duration time.Duration) MillisDuration {
+	return MillisDuration{Duration: duration}
+}
+
+// MarshalJSON returns the duration as an integer count of milliseconds,
+// truncated toward zero.
+func (d MillisDuration) MarshalJSON() ([]byte, error) {
+	return []byte(strconv.FormatInt(int64(d.Duration/time.Millisecond), 10)), nil
+}
+
+// UnmarshalJSON reads a JSON integer as a millisecond count, or a quoted
+// Go duration string like "5s" for backward compatibility.
+func (d *MillisDuration) UnmarshalJSON(text []byte) error {
+	if len(text) == 0 {
+		return errors.New("empty duration")
+	}
+	if text[0] == '"' {
+		s, err := strconv.Unquote(string(text))
+		if err != nil {
+			return errors.Trace(err)
+		}
+		duration, err := time.ParseDuration(s)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		d.Duration = duration
+		return nil
+	}
+	millis, err := strconv.ParseInt(string(text), 10, 64)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	d.Duration = time.Duration(millis) * time.Millisecond
+	return nil
+}
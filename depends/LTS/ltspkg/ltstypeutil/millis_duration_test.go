@@ -0,0 +1,44 @@
+package ltstypeutil
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestMillisDurationMarshalJSONTruncates(t *testing.T) {
+	cases := []struct {
+		in   time.Duration
+		want string
+	}{
+		{750 * time.Microsecond, "0"},
+		{1500 * time.Millisecond, "1500"},
+	}
+	for _, c := range cases {
+		data, err := json.Marshal(NewMillisDuration(c.in))
+		if err != nil {
+			t.Fatalf("Marshal(%s): %v", c.in, err)
+		}
+		if string(data) != c.want {
+			t.Errorf("Marshal(%s) = %s, want %s", c.in, data, c.want)
+		}
+	}
+}
+
+func TestMillisDurationUnmarshalJSON(t *testing.T) {
+	var d MillisDuration
+	if err := json.Unmarshal([]byte("1500"), &d); err != nil {
+		t.Fatalf("Unmarshal(1500): %v", err)
+	}
+	if d.Duration != 1500*time.Millisecond {
+		t.Errorf("Unmarshal(1500) = %s, want 1.5s", d.Duration)
+	}
+
+	var s MillisDuration
+	if err := json.Unmarshal([]byte(`"5s"`), &s); err != nil {
+		t.Fatalf(`Unmarshal("5s"): %v`, err)
+	}
+	if s.Duration != 5*time.Second {
+		t.Errorf(`Unmarshal("5s") = %s, want 5s`, s.Duration)
+	}
+}
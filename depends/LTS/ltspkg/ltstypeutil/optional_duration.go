@@ -0,0 +1,48 @@
+package ltstypeutil
+
+import (
+	"time"
+
+	"github.com/juju/errors"
+)
+
+// OptionalDuration wraps Duration with a "set" flag, for API fields where
+// a zero duration and an absent one mean different things: JSON null
+// leaves it unset, while any string value (including "0s") marks it set.
+type OptionalDuration struct {
+	duration Duration
+	set      bool
+}
+
+// NewOptionalDuration returns an OptionalDuration set to d.
+func NewOptionalDuration(d time.Duration) OptionalDuration {
+	return OptionalDuration{duration: NewDuration(d), set: true}
+}
+
+// Get returns d's value and whether it was set.
+func (d OptionalDuration) Get() (time.Duration, bool) {
+	return d.duration.Duration, d.set
+}
+
+// MarshalJSON returns null when d is unset, and its string form otherwise.
+func (d OptionalDuration) MarshalJSON() ([]byte, error) {
+	if !d.set {
+		return []byte("null"), nil
+	}
+	return d.duration.MarshalJSON()
+}
+
+// UnmarshalJSON leaves d unset for a JSON null, and otherwise parses text
+// as a Duration and marks d set.
+func (d *OptionalDuration) UnmarshalJSON(text []byte) error {
+	if string(text) == "null" {
+		d.duration = Duration{}
+		d.set = false
+		return nil
+	}
+	if err := d.duration.UnmarshalJSON(text); err != nil {
+		return errors.Trace(err)
+	}
+	d.set = true
+	return nil
+}
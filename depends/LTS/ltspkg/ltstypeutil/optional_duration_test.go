@@ -0,0 +1,50 @@
+package ltstypeutil
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestOptionalDurationUnmarshalJSONNull(t *testing.T) {
+	var d OptionalDuration
+	if err := json.Unmarshal([]byte("null"), &d); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+	if _, ok := d.Get(); ok {
+		t.Error("Get() set = true after null, want false")
+	}
+}
+
+func TestOptionalDurationUnmarshalJSONZero(t *testing.T) {
+	var d OptionalDuration
+	if err := json.Unmarshal([]byte(`"0s"`), &d); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+	value, ok := d.Get()
+	if !ok || value != 0 {
+		t.Errorf("Get() = %s, %v, want 0s, true", value, ok)
+	}
+}
+
+func TestOptionalDurationUnmarshalJSONValue(t *testing.T) {
+	var d OptionalDuration
+	if err := json.Unmarshal([]byte(`"5s"`), &d); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+	value, ok := d.Get()
+	if !ok || value != 5*time.Second {
+		t.Errorf("Get() = %s, %v, want 5s, true", value, ok)
+	}
+}
+
+func TestOptionalDurationMarshalJSONUnset(t *testing.T) {
+	var d OptionalDuration
+	data, err := json.Marshal(d)
+	if err != nil {
+		t.Fatalf("Marshal: %v", err)
+	}
+	if string(data) != "null" {
+		t.Errorf("Marshal = %s, want null", data)
+	}
+}
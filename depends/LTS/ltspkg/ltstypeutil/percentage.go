@@ -0,0 +1,74 @@
+package ltstypeutil
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/juju/errors"
+)
+
+// Percentage is a wrapper of float64 for config values like sampling rates
+// and thresholds. The stored value is the fraction, e.g. 0.5 for "50%".
+type Percentage float64
+
+// Fraction returns p as a fraction in [0, 1] (or outside it, if p was
+// never validated).
+func (p Percentage) Fraction() float64 {
+	return float64(p)
+}
+
+// Validate returns an error if p falls outside the closed interval [0, 1].
+func (p Percentage) Validate() error {
+	if p < 0 || p > 1 {
+		return errors.Errorf("ltstypeutil: percentage %s is outside [0%%, 100%%]", p)
+	}
+	return nil
+}
+
+// String renders p in "50%" form.
+func (p Percentage) String() string {
+	return strconv.FormatFloat(float64(p)*100, 'f', -1, 64) + "%"
+}
+
+// MarshalJSON returns p in "50%" string form.
+func (p Percentage) MarshalJSON() ([]byte, error) {
+	return []byte(`"` + p.String() + `"`), nil
+}
+
+// UnmarshalJSON parses a JSON "50%" string or a bare fraction like 0.5.
+func (p *Percentage) UnmarshalJSON(text []byte) error {
+	if len(text) == 0 {
+		return errors.New("empty percentage")
+	}
+	if text[0] == '"' {
+		s, err := strconv.Unquote(string(text))
+		if err != nil {
+			return errors.Trace(err)
+		}
+		parsed, err := parsePercentage(s)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		*p = parsed
+		return nil
+	}
+	fraction, err := strconv.ParseFloat(string(text), 64)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	*p = Percentage(fraction)
+	return nil
+}
+
+// parsePercentage parses a "50%" string into its fraction.
+func parsePercentage(s string) (Percentage, error) {
+	s = strings.TrimSpace(s)
+	if !strings.HasSuffix(s, "%") {
+		return 0, errors.Errorf("ltstypeutil: invalid percentage %q", s)
+	}
+	value, err := strconv.ParseFloat(strings.TrimSuffix(s, "%"), 64)
+	if err != nil {
+		return 0, errors.Annotatef(err, "invalid percentage %q", s)
+	}
+	return Percentage(value / 100), nil
+}
@@ -0,0 +1,45 @@
+package ltstypeutil
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestPercentageJSONRoundTrip(t *testing.T) {
+	cases := []string{`"100%"`, `"0%"`, `"12.5%"`}
+	for _, in := range cases {
+		var p Percentage
+		if err := json.Unmarshal([]byte(in), &p); err != nil {
+			t.Fatalf("Unmarshal(%s): %v", in, err)
+		}
+		out, err := json.Marshal(&p)
+		if err != nil {
+			t.Fatalf("Marshal: %v", err)
+		}
+		if string(out) != in {
+			t.Errorf("round trip mismatch: got %s, want %s", out, in)
+		}
+	}
+}
+
+func TestPercentageUnmarshalJSONBareFraction(t *testing.T) {
+	var p Percentage
+	if err := json.Unmarshal([]byte("0.5"), &p); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+	if p.Fraction() != 0.5 {
+		t.Errorf("Fraction() = %v, want 0.5", p.Fraction())
+	}
+}
+
+func TestPercentageValidate(t *testing.T) {
+	if err := Percentage(0.5).Validate(); err != nil {
+		t.Errorf("Validate(0.5): %v", err)
+	}
+	if err := Percentage(1.5).Validate(); err == nil {
+		t.Error("Validate(1.5): expected an error, got nil")
+	}
+	if err := Percentage(-0.1).Validate(); err == nil {
+		t.Error("Validate(-0.1): expected an error, got nil")
+	}
+}
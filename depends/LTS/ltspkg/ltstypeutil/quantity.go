@@ -0,0 +1,75 @@
+package ltstypeutil
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/juju/errors"
+)
+
+// Quantity pairs a number with an informational unit string, for config
+// like "5 requests" or "3 shards" where the unit carries no computational
+// meaning of its own.
+type Quantity struct {
+	value int64
+	unit  string
+}
+
+// NewQuantity returns a Quantity of value with the given unit.
+func NewQuantity(value int64, unit string) Quantity {
+	return Quantity{value: value, unit: unit}
+}
+
+// Value returns the numeric value.
+func (q Quantity) Value() int64 {
+	return q.value
+}
+
+// Unit returns the unit string, or "" if the quantity is unitless.
+func (q Quantity) Unit() string {
+	return q.unit
+}
+
+// String renders q as "<value> <unit>", or just "<value>" when unitless.
+func (q Quantity) String() string {
+	if q.unit == "" {
+		return strconv.FormatInt(q.value, 10)
+	}
+	return strconv.FormatInt(q.value, 10) + " " + q.unit
+}
+
+// MarshalJSON returns q in its canonical "<value> <unit>" string form.
+func (q Quantity) MarshalJSON() ([]byte, error) {
+	return []byte(`"` + q.String() + `"`), nil
+}
+
+// UnmarshalJSON parses a JSON "<value> <unit>" string.
+func (q *Quantity) UnmarshalJSON(text []byte) error {
+	if len(text) < 2 || text[0] != '"' {
+		return errors.Errorf("ltstypeutil: invalid Quantity %s", text)
+	}
+	s, err := unquoteJSONString(text)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	return errors.Trace(q.UnmarshalText([]byte(s)))
+}
+
+// UnmarshalText parses a "<number> <unit>" string. Surrounding and repeated
+// whitespace between the number and unit is tolerated; the unit is
+// optional.
+func (q *Quantity) UnmarshalText(text []byte) error {
+	fields := strings.Fields(string(text))
+	if len(fields) == 0 {
+		return errors.Errorf("ltstypeutil: empty Quantity")
+	}
+
+	value, err := strconv.ParseInt(fields[0], 10, 64)
+	if err != nil {
+		return errors.Annotatef(err, "ltstypeutil: invalid Quantity %q", text)
+	}
+
+	q.value = value
+	q.unit = strings.Join(fields[1:], " ")
+	return nil
+}
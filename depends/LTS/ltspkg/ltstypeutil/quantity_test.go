@@ -0,0 +1,36 @@
+package ltstypeutil
+
+import "testing"
+
+func TestQuantityUnmarshalText(t *testing.T) {
+	cases := []struct {
+		in        string
+		value     int64
+		unit      string
+		canonical string
+	}{
+		{"5", 5, "", "5"},
+		{"5 requests", 5, "requests", "5 requests"},
+		{"  5   requests  ", 5, "requests", "5 requests"},
+	}
+	for _, c := range cases {
+		var q Quantity
+		if err := q.UnmarshalText([]byte(c.in)); err != nil {
+			t.Errorf("UnmarshalText(%q): %v", c.in, err)
+			continue
+		}
+		if q.Value() != c.value || q.Unit() != c.unit {
+			t.Errorf("UnmarshalText(%q) = {%d %q}, want {%d %q}", c.in, q.Value(), q.Unit(), c.value, c.unit)
+		}
+		if got := q.String(); got != c.canonical {
+			t.Errorf("String() = %q, want %q", got, c.canonical)
+		}
+	}
+}
+
+func TestQuantityUnmarshalTextMalformed(t *testing.T) {
+	var q Quantity
+	if err := q.UnmarshalText([]byte("abc requests")); err == nil {
+		t.Error("UnmarshalText(\"abc requests\"): expected an error, got nil")
+	}
+}
@@ -0,0 +1,84 @@
+package ltstypeutil
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/juju/errors"
+)
+
+// RateLimit is a count of events allowed per a given period, for config
+// fields like "100/s" or "1000/m".
+type RateLimit struct {
+	Count  int64
+	Period Duration
+}
+
+// NewRateLimit returns a RateLimit of count events per period.
+func NewRateLimit(count int64, period Duration) RateLimit {
+	return RateLimit{Count: count, Period: period}
+}
+
+// PerSecond returns the rate as events per second.
+func (r RateLimit) PerSecond() float64 {
+	if r.Period.Duration <= 0 {
+		return 0
+	}
+	return float64(r.Count) / r.Period.Duration.Seconds()
+}
+
+// String renders r in "N/period" form, e.g. "100/1s".
+func (r RateLimit) String() string {
+	return strconv.FormatInt(r.Count, 10) + "/" + r.Period.String()
+}
+
+// MarshalJSON returns r in "N/period" string form.
+func (r RateLimit) MarshalJSON() ([]byte, error) {
+	return []byte(`"` + r.String() + `"`), nil
+}
+
+// UnmarshalJSON parses a JSON "N/period" string.
+func (r *RateLimit) UnmarshalJSON(text []byte) error {
+	if len(text) < 2 || text[0] != '"' {
+		return errors.Errorf("ltstypeutil: invalid RateLimit %s", text)
+	}
+	s, err := unquoteJSONString(text)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	return errors.Trace(r.UnmarshalText([]byte(s)))
+}
+
+// UnmarshalText parses a "N/period" string, e.g. "100/s" or "1000/1m".
+func (r *RateLimit) UnmarshalText(text []byte) error {
+	s := string(text)
+	idx := strings.IndexByte(s, '/')
+	if idx < 0 {
+		return errors.Errorf("ltstypeutil: invalid RateLimit %q, want \"N/period\"", s)
+	}
+
+	count, err := strconv.ParseInt(strings.TrimSpace(s[:idx]), 10, 64)
+	if err != nil {
+		return errors.Annotatef(err, "ltstypeutil: invalid RateLimit %q", s)
+	}
+	if count <= 0 {
+		return errors.Errorf("ltstypeutil: invalid RateLimit %q: count must be positive", s)
+	}
+
+	periodStr := strings.TrimSpace(s[idx+1:])
+	if periodStr != "" && (periodStr[0] < '0' || periodStr[0] > '9') {
+		// Allow the bare-unit shorthand "100/s" to mean "100 per 1s".
+		periodStr = "1" + periodStr
+	}
+	period, err := ParseExtendedDuration(periodStr)
+	if err != nil {
+		return errors.Annotatef(err, "ltstypeutil: invalid RateLimit %q", s)
+	}
+	if period <= 0 {
+		return errors.Errorf("ltstypeutil: invalid RateLimit %q: period must be positive", s)
+	}
+
+	r.Count = count
+	r.Period = NewDuration(period)
+	return nil
+}
@@ -0,0 +1,65 @@
+package ltstypeutil
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestRateLimitUnmarshalText(t *testing.T) {
+	cases := []struct {
+		in        string
+		count     int64
+		period    time.Duration
+		perSecond float64
+	}{
+		{"100/s", 100, time.Second, 100},
+		{"1000/1m", 1000, time.Minute, 1000.0 / 60},
+		{"5/100ms", 5, 100 * time.Millisecond, 50},
+	}
+	for _, c := range cases {
+		var r RateLimit
+		if err := r.UnmarshalText([]byte(c.in)); err != nil {
+			t.Errorf("UnmarshalText(%q): %v", c.in, err)
+			continue
+		}
+		if r.Count != c.count || r.Period.Duration != c.period {
+			t.Errorf("UnmarshalText(%q) = %+v, want Count=%d Period=%s", c.in, r, c.count, c.period)
+		}
+		if got := r.PerSecond(); got != c.perSecond {
+			t.Errorf("PerSecond() = %v, want %v", got, c.perSecond)
+		}
+	}
+}
+
+func TestRateLimitUnmarshalTextInvalid(t *testing.T) {
+	for _, in := range []string{"100", "abc/s", "100/notaduration"} {
+		var r RateLimit
+		if err := r.UnmarshalText([]byte(in)); err == nil {
+			t.Errorf("UnmarshalText(%q): expected an error, got nil", in)
+		}
+	}
+}
+
+func TestRateLimitUnmarshalTextRejectsNonPositiveBounds(t *testing.T) {
+	for _, in := range []string{"-5/s", "0/s", "5/0s"} {
+		var r RateLimit
+		if err := r.UnmarshalText([]byte(in)); err == nil {
+			t.Errorf("UnmarshalText(%q): expected an error, got nil", in)
+		}
+	}
+}
+
+func TestRateLimitJSONRoundTrip(t *testing.T) {
+	var r RateLimit
+	if err := json.Unmarshal([]byte(`"100/s"`), &r); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+	data, err := json.Marshal(&r)
+	if err != nil {
+		t.Fatalf("Marshal: %v", err)
+	}
+	if string(data) != `"100/1s"` {
+		t.Errorf("Marshal = %s, want \"100/1s\"", data)
+	}
+}
@@ -0,0 +1,62 @@
+package ltstypeutil
+
+import (
+	"regexp"
+	"strconv"
+
+	"github.com/juju/errors"
+)
+
+// Regexp is a wrapper of *regexp.Regexp for config fields holding a
+// pattern, compiled immediately at decode time instead of lazily at
+// first use.
+type Regexp struct {
+	*regexp.Regexp
+
+	source string
+}
+
+// MatchString reports whether s matches the regexp. An unset Regexp
+// (source was empty) matches nothing.
+func (r Regexp) MatchString(s string) bool {
+	if r.Regexp == nil {
+		return false
+	}
+	return r.Regexp.MatchString(s)
+}
+
+// MarshalJSON returns the original pattern source as a JSON string.
+func (r Regexp) MarshalJSON() ([]byte, error) {
+	return []byte(strconv.Quote(r.source)), nil
+}
+
+// UnmarshalJSON compiles a JSON string pattern. An empty pattern
+// unmarshals to a nil matcher.
+func (r *Regexp) UnmarshalJSON(text []byte) error {
+	if len(text) < 2 || text[0] != '"' {
+		return errors.Errorf("ltstypeutil: invalid Regexp %s", text)
+	}
+	source, err := unquoteJSONString(text)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	return errors.Trace(r.UnmarshalText([]byte(source)))
+}
+
+// UnmarshalText compiles a pattern. An empty pattern unmarshals to a nil
+// matcher.
+func (r *Regexp) UnmarshalText(text []byte) error {
+	source := string(text)
+	if source == "" {
+		r.Regexp = nil
+		r.source = ""
+		return nil
+	}
+	compiled, err := regexp.Compile(source)
+	if err != nil {
+		return errors.Annotatef(err, "ltstypeutil: invalid pattern %q", source)
+	}
+	r.Regexp = compiled
+	r.source = source
+	return nil
+}
@@ -0,0 +1,70 @@
+package ltstypeutil
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestRegexpJSONRoundTrip(t *testing.T) {
+	var r Regexp
+	if err := json.Unmarshal([]byte(`"^foo.*bar$"`), &r); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+	if !r.MatchString("foobazbar") {
+		t.Error("MatchString(foobazbar) = false, want true")
+	}
+
+	data, err := json.Marshal(&r)
+	if err != nil {
+		t.Fatalf("Marshal: %v", err)
+	}
+	if string(data) != `"^foo.*bar$"` {
+		t.Errorf("Marshal = %s, want \"^foo.*bar$\"", data)
+	}
+}
+
+func TestRegexpJSONRoundTripEscapesBackslashes(t *testing.T) {
+	var r Regexp
+	if err := json.Unmarshal([]byte(`"^\\d+$"`), &r); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+	if !r.MatchString("123") {
+		t.Error("MatchString(123) = false, want true")
+	}
+
+	data, err := json.Marshal(&r)
+	if err != nil {
+		t.Fatalf("Marshal: %v", err)
+	}
+	if string(data) != `"^\\d+$"` {
+		t.Errorf("Marshal = %s, want \"^\\\\d+$\"", data)
+	}
+
+	var round Regexp
+	if err := json.Unmarshal(data, &round); err != nil {
+		t.Fatalf("round-trip Unmarshal: %v", err)
+	}
+	if !round.MatchString("456") {
+		t.Error("round-trip MatchString(456) = false, want true")
+	}
+}
+
+func TestRegexpInvalidPattern(t *testing.T) {
+	var r Regexp
+	if err := json.Unmarshal([]byte(`"(unclosed"`), &r); err == nil {
+		t.Fatal(`Unmarshal("(unclosed"): expected an error, got nil`)
+	}
+}
+
+func TestRegexpEmptyMatchesNothing(t *testing.T) {
+	var r Regexp
+	if err := json.Unmarshal([]byte(`""`), &r); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+	if r.Regexp != nil {
+		t.Errorf("Regexp = %v, want nil", r.Regexp)
+	}
+	if r.MatchString("anything") {
+		t.Error("MatchString(anything) = true, want false for an unset Regexp")
+	}
+}
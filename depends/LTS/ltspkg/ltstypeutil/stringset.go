@@ -0,0 +1,63 @@
+package ltstypeutil
+
+import (
+	"encoding/json"
+	"sort"
+
+	"github.com/juju/errors"
+)
+
+// StringSet is a deduplicated set of strings for config fields like
+// allow-lists and feature toggles, where JSON/TOML arrays must be unique.
+type StringSet map[string]struct{}
+
+// NewStringSet builds a StringSet from values, collapsing duplicates.
+func NewStringSet(values ...string) StringSet {
+	s := make(StringSet, len(values))
+	for _, v := range values {
+		s.Add(v)
+	}
+	return s
+}
+
+// Contains reports whether s contains v.
+func (s StringSet) Contains(v string) bool {
+	_, ok := s[v]
+	return ok
+}
+
+// Add inserts v into s.
+func (s StringSet) Add(v string) {
+	s[v] = struct{}{}
+}
+
+// Slice returns the set's members as a sorted slice.
+func (s StringSet) Slice() []string {
+	out := make([]string, 0, len(s))
+	for v := range s {
+		out = append(out, v)
+	}
+	sort.Strings(out)
+	return out
+}
+
+// MarshalJSON returns the set as a sorted JSON array, so config diffs
+// stay stable regardless of map iteration order.
+func (s StringSet) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.Slice())
+}
+
+// UnmarshalJSON reads a JSON array into the set, collapsing duplicates. A
+// JSON null yields an empty, non-nil set.
+func (s *StringSet) UnmarshalJSON(text []byte) error {
+	if string(text) == "null" {
+		*s = StringSet{}
+		return nil
+	}
+	var values []string
+	if err := json.Unmarshal(text, &values); err != nil {
+		return errors.Trace(err)
+	}
+	*s = NewStringSet(values...)
+	return nil
+}
@@ -0,0 +1,47 @@
+package ltstypeutil
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestStringSetUnmarshalJSONDedups(t *testing.T) {
+	var s StringSet
+	if err := json.Unmarshal([]byte(`["a","b","a"]`), &s); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+	if len(s) != 2 || !s.Contains("a") || !s.Contains("b") {
+		t.Errorf("got %v, want {a, b}", s)
+	}
+}
+
+func TestStringSetUnmarshalJSONNull(t *testing.T) {
+	s := NewStringSet("a")
+	if err := json.Unmarshal([]byte("null"), &s); err != nil {
+		t.Fatalf("Unmarshal(null): %v", err)
+	}
+	if s == nil || len(s) != 0 {
+		t.Errorf("Unmarshal(null) = %v, want empty non-nil set", s)
+	}
+}
+
+func TestStringSetMarshalJSONIsSorted(t *testing.T) {
+	s := NewStringSet("zebra", "apple", "mango")
+	data, err := json.Marshal(s)
+	if err != nil {
+		t.Fatalf("Marshal: %v", err)
+	}
+	if string(data) != `["apple","mango","zebra"]` {
+		t.Errorf("Marshal = %s, want sorted array", data)
+	}
+}
+
+func TestStringSetAddAndSlice(t *testing.T) {
+	s := NewStringSet()
+	s.Add("b")
+	s.Add("a")
+	s.Add("b")
+	if got := s.Slice(); len(got) != 2 || got[0] != "a" || got[1] != "b" {
+		t.Errorf("Slice() = %v, want [a b]", got)
+	}
+}
@@ -0,0 +1,73 @@
+package ltstypeutil
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/juju/errors"
+)
+
+// TimeOfDay is a wall-clock time of day, stored as seconds since midnight,
+// for scheduling config like maintenance windows ("02:30" meaning 2:30 AM
+// local).
+type TimeOfDay struct {
+	SecondsSinceMidnight int
+}
+
+// NewTimeOfDay creates a TimeOfDay from an hour, minute and second.
+func NewTimeOfDay(hour, minute, second int) TimeOfDay {
+	return TimeOfDay{SecondsSinceMidnight: hour*3600 + minute*60 + second}
+}
+
+// Next returns the next occurrence of the time of day strictly after
+// after, in after's location, rolling over to the following day when the
+// time of day has already passed today.
+func (t TimeOfDay) Next(after time.Time) time.Time {
+	hour := t.SecondsSinceMidnight / 3600
+	minute := (t.SecondsSinceMidnight % 3600) / 60
+	second := t.SecondsSinceMidnight % 60
+
+	y, m, d := after.Date()
+	next := time.Date(y, m, d, hour, minute, second, 0, after.Location())
+	if !next.After(after) {
+		next = next.AddDate(0, 0, 1)
+	}
+	return next
+}
+
+// String renders t as "HH:MM".
+func (t TimeOfDay) String() string {
+	return fmt.Sprintf("%02d:%02d", t.SecondsSinceMidnight/3600, (t.SecondsSinceMidnight%3600)/60)
+}
+
+// MarshalJSON returns t in canonical "HH:MM" form.
+func (t TimeOfDay) MarshalJSON() ([]byte, error) {
+	return []byte(`"` + t.String() + `"`), nil
+}
+
+// UnmarshalJSON parses a JSON "15:04" or "15:04:05" string.
+func (t *TimeOfDay) UnmarshalJSON(text []byte) error {
+	if len(text) < 2 || text[0] != '"' {
+		return errors.Errorf("ltstypeutil: invalid TimeOfDay %s", text)
+	}
+	s, err := unquoteJSONString(text)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	return errors.Trace(t.UnmarshalText([]byte(s)))
+}
+
+// UnmarshalText parses a "15:04" or "15:04:05" string.
+func (t *TimeOfDay) UnmarshalText(text []byte) error {
+	s := string(text)
+	parsed, err := time.Parse("15:04:05", s)
+	if err != nil {
+		parsed, err = time.Parse("15:04", s)
+		if err != nil {
+			return errors.Annotatef(err, "ltstypeutil: invalid time of day %q", s)
+		}
+	}
+	hour, minute, second := parsed.Clock()
+	*t = NewTimeOfDay(hour, minute, second)
+	return nil
+}
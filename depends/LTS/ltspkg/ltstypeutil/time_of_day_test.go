@@ -0,0 +1,57 @@
+package ltstypeutil
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestTimeOfDayJSONRoundTrip(t *testing.T) {
+	var tod TimeOfDay
+	if err := json.Unmarshal([]byte(`"02:30"`), &tod); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+	if tod.SecondsSinceMidnight != 2*3600+30*60 {
+		t.Errorf("SecondsSinceMidnight = %d, want %d", tod.SecondsSinceMidnight, 2*3600+30*60)
+	}
+
+	data, err := json.Marshal(&tod)
+	if err != nil {
+		t.Fatalf("Marshal: %v", err)
+	}
+	if string(data) != `"02:30"` {
+		t.Errorf("Marshal = %s, want \"02:30\"", data)
+	}
+}
+
+func TestTimeOfDayInvalid(t *testing.T) {
+	cases := []string{"25:00", "10:70"}
+	for _, in := range cases {
+		var tod TimeOfDay
+		if err := tod.UnmarshalText([]byte(in)); err == nil {
+			t.Errorf("UnmarshalText(%q): expected an error, got nil", in)
+		}
+	}
+}
+
+func TestTimeOfDayNextRollsOverToNextDay(t *testing.T) {
+	tod := NewTimeOfDay(2, 30, 0)
+	after := time.Date(2026, 1, 1, 5, 0, 0, 0, time.UTC)
+
+	next := tod.Next(after)
+	want := time.Date(2026, 1, 2, 2, 30, 0, 0, time.UTC)
+	if !next.Equal(want) {
+		t.Errorf("Next(%s) = %s, want %s", after, next, want)
+	}
+}
+
+func TestTimeOfDayNextSameDay(t *testing.T) {
+	tod := NewTimeOfDay(14, 0, 0)
+	after := time.Date(2026, 1, 1, 5, 0, 0, 0, time.UTC)
+
+	next := tod.Next(after)
+	want := time.Date(2026, 1, 1, 14, 0, 0, 0, time.UTC)
+	if !next.Equal(want) {
+		t.Errorf("Next(%s) = %s, want %s", after, next, want)
+	}
+}
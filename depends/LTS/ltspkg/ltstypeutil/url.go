@@ -0,0 +1,61 @@
+package ltstypeutil
+
+import (
+	"net/url"
+
+	"github.com/juju/errors"
+)
+
+// URL is a wrapper of *url.URL for config endpoints, parsed and validated
+// at decode time instead of at every use site.
+type URL struct {
+	*url.URL
+
+	// AbsoluteOnly, when set before unmarshaling, rejects a relative URL
+	// (one with no scheme or host).
+	AbsoluteOnly bool
+}
+
+// MarshalJSON returns the URL's canonical string form, or JSON null for an
+// unset URL.
+func (u URL) MarshalJSON() ([]byte, error) {
+	if u.URL == nil {
+		return []byte("null"), nil
+	}
+	return []byte(`"` + u.URL.String() + `"`), nil
+}
+
+// UnmarshalJSON parses a JSON string into the URL. An empty string or
+// JSON null yields a nil inner URL without error.
+func (u *URL) UnmarshalJSON(text []byte) error {
+	if string(text) == "null" {
+		u.URL = nil
+		return nil
+	}
+	if len(text) < 2 || text[0] != '"' {
+		return errors.Errorf("ltstypeutil: invalid URL %s", text)
+	}
+	s, err := unquoteJSONString(text)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	return errors.Trace(u.UnmarshalText([]byte(s)))
+}
+
+// UnmarshalText parses text into the URL. An empty string yields a nil
+// inner URL without error.
+func (u *URL) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		u.URL = nil
+		return nil
+	}
+	parsed, err := url.Parse(string(text))
+	if err != nil {
+		return errors.Annotatef(err, "ltstypeutil: invalid URL %q", text)
+	}
+	if u.AbsoluteOnly && (parsed.Scheme == "" || parsed.Host == "") {
+		return errors.Errorf("ltstypeutil: URL %q must be absolute", text)
+	}
+	u.URL = parsed
+	return nil
+}
@@ -0,0 +1,48 @@
+package ltstypeutil
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestURLJSONRoundTrip(t *testing.T) {
+	var u URL
+	if err := json.Unmarshal([]byte(`"https://example.com/path"`), &u); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+	if u.Scheme != "https" || u.Host != "example.com" {
+		t.Errorf("got scheme=%q host=%q, want https/example.com", u.Scheme, u.Host)
+	}
+
+	data, err := json.Marshal(&u)
+	if err != nil {
+		t.Fatalf("Marshal: %v", err)
+	}
+	if string(data) != `"https://example.com/path"` {
+		t.Errorf("Marshal = %s, want \"https://example.com/path\"", data)
+	}
+}
+
+func TestURLEmptyStringYieldsNilURL(t *testing.T) {
+	var u URL
+	if err := json.Unmarshal([]byte(`""`), &u); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+	if u.URL != nil {
+		t.Errorf("URL = %v, want nil", u.URL)
+	}
+}
+
+func TestURLInvalidFailsLoudly(t *testing.T) {
+	var u URL
+	if err := json.Unmarshal([]byte(`"://bad"`), &u); err == nil {
+		t.Fatal(`Unmarshal("://bad"): expected an error, got nil`)
+	}
+}
+
+func TestURLAbsoluteOnlyRejectsRelative(t *testing.T) {
+	u := URL{AbsoluteOnly: true}
+	if err := u.UnmarshalText([]byte("/just/a/path")); err == nil {
+		t.Fatal("UnmarshalText(relative): expected an error, got nil")
+	}
+}